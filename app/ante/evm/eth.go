@@ -154,6 +154,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	homestead := ethCfg.IsHomestead(blockHeight)
 	istanbul := ethCfg.IsIstanbul(blockHeight)
+	shanghai := ethCfg.IsShanghai(blockHeight)
 	var events sdk.Events
 
 	// Use the lowest priority of all the messages as the final one.
@@ -183,7 +184,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			gasWanted += txData.GetGas()
 		}
 
-		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, ctx.IsCheckTx())
+		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, shanghai, ctx.IsCheckTx())
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
@@ -245,8 +246,8 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
-// CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
-// context rules.
+// CanTransferDecorator checks if the sender is allowed to transfer funds, deploy a contract or call
+// the recipient according to the EVM block context rules.
 type CanTransferDecorator struct {
 	evmKeeper EVMKeeper
 }
@@ -258,8 +259,8 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 	}
 }
 
-// AnteHandle creates an EVM from the message and calls the BlockContext CanTransfer function to
-// see if the address can execute the transaction.
+// AnteHandle creates an EVM from the message and calls the BlockContext CanTransfer, CanCreate and
+// CanCall functions to see if the address can execute the transaction.
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
@@ -318,6 +319,23 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 				coreMsg.From(),
 			)
 		}
+
+		// check that the sender can deploy contracts or that the called contract isn't frozen for
+		// the **topmost** call, the nested calls are checked by the EVM
+		if coreMsg.To() == nil && evm.Context.CanCreate != nil && !evm.Context.CanCreate(stateDB, coreMsg.From()) {
+			return ctx, errorsmod.Wrapf(
+				evmtypes.ErrDeployerNotAllowed,
+				"address %s is not in the deployer allowlist",
+				coreMsg.From(),
+			)
+		}
+		if coreMsg.To() != nil && evm.Context.CanCall != nil && !evm.Context.CanCall(stateDB, *coreMsg.To()) {
+			return ctx, errorsmod.Wrapf(
+				evmtypes.ErrContractFrozen,
+				"failed to call frozen contract %s",
+				coreMsg.To(),
+			)
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -15,6 +15,7 @@ import (
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -538,6 +539,15 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 	err := tx.Sign(suite.ethSigner, testutiltx.NewSigner(privKey))
 	suite.Require().NoError(err)
 
+	contract := testutiltx.GenerateAddress()
+	ethCallTxParams := *ethContractCreationTxParams
+	ethCallTxParams.To = &contract
+	callTx := evmtypes.NewTx(&ethCallTxParams)
+	callTx.From = addr.Hex()
+
+	err = callTx.Sign(suite.ethSigner, testutiltx.NewSigner(privKey))
+	suite.Require().NoError(err)
+
 	var vmdb *statedb.StateDB
 
 	testCases := []struct {
@@ -568,10 +578,48 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 			},
 			true,
 		},
+		{
+			"deployer not in the allowlist",
+			tx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+				suite.app.EvmKeeper.SetDeployerAllowlist(suite.ctx, []common.Address{contract})
+			},
+			false,
+		},
+		{
+			"deployer in the allowlist",
+			tx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+				suite.app.EvmKeeper.SetDeployerAllowlist(suite.ctx, []common.Address{addr})
+			},
+			true,
+		},
+		{
+			"call to frozen contract",
+			callTx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+				suite.app.EvmKeeper.SetFrozenContracts(suite.ctx, []common.Address{contract})
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
+			suite.app.EvmKeeper.SetDeployerAllowlist(suite.ctx, nil)
+			suite.app.EvmKeeper.SetFrozenContracts(suite.ctx, nil)
 			vmdb = testutil.NewStateDB(suite.ctx, suite.app.EvmKeeper)
 			tc.malleate()
 			suite.Require().NoError(vmdb.Commit())
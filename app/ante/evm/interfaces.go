@@ -7,9 +7,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 	feemarkettypes "github.com/servprotocolorg/serv/v12/x/feemarket/types"
@@ -144,8 +144,8 @@ import (
 	transferkeeper "github.com/servprotocolorg/serv/v12/x/ibc/transfer/keeper"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
-	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
-	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	_ "github.com/servprotocolorg/serv/v12/x/evm/core/tracers/js"
+	_ "github.com/servprotocolorg/serv/v12/x/evm/core/tracers/native"
 )
 
 func init() {
@@ -482,6 +482,12 @@ func NewServ(
 		),
 	)
 
+	chainApp.EvmKeeper.SetPrecompiles(
+		NewAvailablePrecompiles(
+			chainApp.BankKeeper, chainApp.StakingKeeper, chainApp.DistrKeeper, chainApp.AuthzKeeper, chainApp.Erc20Keeper,
+		)...,
+	)
+
 	chainApp.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], chainApp.GetSubspace(ibctransfertypes.ModuleName),
 		chainApp.IBCKeeper.ChannelKeeper, // No ICS4 wrapper
@@ -0,0 +1,55 @@
+package app
+
+import (
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	bankprecompile "github.com/servprotocolorg/serv/v12/precompiles/bank"
+	blockhashprecompile "github.com/servprotocolorg/serv/v12/precompiles/blockhash"
+	distrprecompile "github.com/servprotocolorg/serv/v12/precompiles/distribution"
+	ics20precompile "github.com/servprotocolorg/serv/v12/precompiles/ics20"
+	stakingprecompile "github.com/servprotocolorg/serv/v12/precompiles/staking"
+	erc20keeper "github.com/servprotocolorg/serv/v12/x/erc20/keeper"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// NewAvailablePrecompiles returns the built-in stateful precompiled contracts wrapping the
+// Cosmos SDK and IBC modules and the block hash history contract, which isn't active by default.
+// It panics if one of them cannot be created.
+func NewAvailablePrecompiles(
+	bankKeeper bankkeeper.Keeper,
+	stakingKeeper *stakingkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
+) []evmtypes.StatefulPrecompiledContract {
+	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, authzKeeper)
+	if err != nil {
+		panic(err)
+	}
+
+	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
+	if err != nil {
+		panic(err)
+	}
+
+	distrPrecompile, err := distrprecompile.NewPrecompile(distrkeeper.NewQuerier(distrKeeper), authzKeeper)
+	if err != nil {
+		panic(err)
+	}
+
+	ics20Precompile, err := ics20precompile.NewPrecompile(authzKeeper, erc20Keeper)
+	if err != nil {
+		panic(err)
+	}
+
+	return []evmtypes.StatefulPrecompiledContract{
+		stakingPrecompile,
+		distrPrecompile,
+		ics20Precompile,
+		bankPrecompile,
+		blockhashprecompile.NewPrecompile(),
+	}
+}
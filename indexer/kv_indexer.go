@@ -24,12 +24,17 @@ import (
 const (
 	KeyPrefixTxHash  = 1
 	KeyPrefixTxIndex = 2
+	// KeyPrefixSkippedBlock prefixes the blocks the indexer failed to index
+	KeyPrefixSkippedBlock = 8
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
-var _ evertypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ evertypes.EVMTxIndexer  = &KVIndexer{}
+	_ evertypes.EVMLogIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements an ETH-Tx indexer on a KV db.
 type KVIndexer struct {
@@ -63,74 +68,43 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a `indexer.TxResult` based on parsed events for every message
+// - Indexes the logs of the block by address and topics, and sets the block bits in the bloom bits sections
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	return kv.indexBlock(block, txResults, false)
+}
+
+// indexBlock indexes the block, the entries previously indexed for the block are deleted first
+// if reindex is set.
+func (kv *KVIndexer) indexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx, reindex bool) error {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
-			continue
-		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		if !isEthTx(tx) {
-			continue
-		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
+	bloomBits := newBloomBitsWriter(kv.db)
+	if reindex {
+		if err := kv.deleteBlocks(batch, bloomBits, height, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete entries", height)
 		}
+	}
 
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := common.HexToHash(ethMsg.Hash)
-
-			txResult := evertypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),
-				MsgIndex:   uint32(msgIndex),
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+	for _, tx := range parseEthTxs(kv.clientCtx.TxConfig.TxDecoder(), kv.logger, block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, tx.Hash, &tx.Result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
 	}
+	if err := kv.indexLogs(batch, bloomBits, height, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, index logs", height)
+	}
+	if err := bloomBits.write(batch); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := updateLogIndexedRange(kv.db, batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := batch.Delete(SkippedBlockKey(height)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, delete skipped block key", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -189,6 +163,30 @@ func (kv *KVIndexer) GetLastRequestIndexedBlock() (int64, error) {
 	return kv.lastRequestIndexedBlock, nil
 }
 
+// MarkBlockSkipped persists a block the indexer failed to index, the mark is cleared when the
+// block is indexed.
+func (kv *KVIndexer) MarkBlockSkipped(height int64) error {
+	if err := kv.db.Set(SkippedBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrapf(err, "MarkBlockSkipped %d", height)
+	}
+	return nil
+}
+
+// SkippedBlocks returns the blocks marked as skipped in ascending order.
+func (kv *KVIndexer) SkippedBlocks() ([]int64, error) {
+	it, err := dbm.IteratePrefix(kv.db, []byte{KeyPrefixSkippedBlock})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "SkippedBlocks")
+	}
+	defer it.Close()
+
+	heights := []int64{}
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, int64(sdk.BigEndianToUint64(it.Key()[1:])))
+	}
+	return heights, it.Error()
+}
+
 // getByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) getByTxHash(hash common.Hash) (*evertypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -222,6 +220,11 @@ func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
 }
 
+// SkippedBlockKey returns the key for db entry: `block number -> nil`
+func SkippedBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixSkippedBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
 // TxIndexKey returns the key for db entry: `(block number, tx index) -> tx hash`
 func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
@@ -268,6 +271,86 @@ func isEthTx(tx sdk.Tx) bool {
 	return true
 }
 
+// ethTx is an eth tx of a block with the result indexed for it.
+type ethTx struct {
+	Hash   common.Hash
+	Msg    *evmtypes.MsgEthereumTx
+	Result evertypes.TxResult
+}
+
+// parseEthTxs builds the tx results of all the eth txs of the block.
+//
+// Steps:
+// - Iterates over all-of-the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a `TxResult` based on parsed events for every message
+func parseEthTxs(txDecoder sdk.TxDecoder, logger log.Logger, block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) []*ethTx {
+	height := block.Header.Height
+	ethTxs := []*ethTx{}
+
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := txDecoder(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+
+			txResult := evertypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),
+				MsgIndex:   uint32(msgIndex),
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			ethTxs = append(ethTxs, &ethTx{Hash: common.HexToHash(ethMsg.Hash), Msg: ethMsg, Result: txResult})
+		}
+	}
+	return ethTxs
+}
+
 // saveTxResult index the txResult into the kv db batch
 func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *evertypes.TxResult) error {
 	bz := codec.MustMarshal(txResult)
@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"github.com/servprotocolorg/serv/v12/constants"
 	"math/big"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/servprotocolorg/serv/v12/crypto/ethsecp256k1"
 	evmenc "github.com/servprotocolorg/serv/v12/encoding"
 	"github.com/servprotocolorg/serv/v12/indexer"
+	evertypes "github.com/servprotocolorg/serv/v12/types"
 	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 	dbm "github.com/cometbft/cometbft-db"
@@ -195,3 +197,282 @@ func TestKVIndexer(t *testing.T) {
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+	topic1 := common.BigToHash(big.NewInt(1))
+	topic2 := common.BigToHash(big.NewInt(2))
+	topic3 := common.BigToHash(big.NewInt(3))
+
+	// blockLogs returns the result of a tx emitting the logs
+	blockLogs := func(height int64, logs ...*ethtypes.Log) []*abci.ResponseDeliverTx {
+		attrs := make([]abci.EventAttribute, len(logs))
+		for i, log := range logs {
+			log.BlockNumber = uint64(height)
+			log.Index = uint(i)
+			bz, err := json.Marshal(types.NewLogFromEth(log))
+			require.NoError(t, err)
+			attrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+		return []*abci.ResponseDeliverTx{
+			{Code: 0, Events: []abci.Event{{Type: types.EventTypeTxLog, Attributes: attrs}}},
+		}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	results := map[int64][]*abci.ResponseDeliverTx{
+		1: blockLogs(1, &ethtypes.Log{Address: addr1, Topics: []common.Hash{topic1, topic2}}),
+		2: nil,
+		3: blockLogs(3,
+			&ethtypes.Log{Address: addr2, Topics: []common.Hash{topic1}},
+			&ethtypes.Log{Address: addr1, Topics: []common.Hash{topic2, topic3}},
+		),
+		// the logs of the section boundary blocks are found
+		indexer.BloomBitsSectionSize: blockLogs(indexer.BloomBitsSectionSize, &ethtypes.Log{Address: addr1, Topics: []common.Hash{topic3}}),
+	}
+	for _, height := range []int64{1, 2, 3} {
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, results[height]))
+	}
+
+	first, last, err = idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []uint64 // block numbers of the logs
+		expTopic0 []common.Hash
+		expError  bool
+	}{
+		{"no criteria", 1, 3, nil, nil, 10, []uint64{1, 3, 3}, []common.Hash{topic1, topic1, topic2}, false},
+		{"address", 1, 3, []common.Address{addr1}, nil, 10, []uint64{1, 3}, []common.Hash{topic1, topic2}, false},
+		{"addresses", 2, 3, []common.Address{addr1, addr2}, nil, 10, []uint64{3, 3}, []common.Hash{topic1, topic2}, false},
+		{"first topic", 1, 3, nil, [][]common.Hash{{topic1}}, 10, []uint64{1, 3}, []common.Hash{topic1, topic1}, false},
+		{"second topic", 1, 3, nil, [][]common.Hash{{}, {topic2}}, 10, []uint64{1}, []common.Hash{topic1}, false},
+		{"topic alternatives", 1, 3, nil, [][]common.Hash{{}, {topic2, topic3}}, 10, []uint64{1, 3}, []common.Hash{topic1, topic2}, false},
+		{"address and topic", 1, 3, []common.Address{addr1}, [][]common.Hash{{topic2}}, 10, []uint64{3}, []common.Hash{topic2}, false},
+		{"topic in another position", 1, 3, nil, [][]common.Hash{{topic3}}, 10, []uint64{}, []common.Hash{}, false},
+		{"more topics than the logs", 1, 3, nil, [][]common.Hash{{}, {}, {}}, 10, []uint64{}, []common.Hash{}, false},
+		{"no match", 1, 3, []common.Address{common.BigToAddress(big.NewInt(3))}, nil, 10, []uint64{}, []common.Hash{}, false},
+		{"sub range", 2, 2, nil, nil, 10, []uint64{}, []common.Hash{}, false},
+		{"fail, limit exceeded", 1, 3, nil, nil, 2, nil, nil, true},
+		{"fail, limit exceeded with criteria", 1, 3, []common.Address{addr1}, nil, 1, nil, nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, logs, len(tc.expLogs))
+			for i, log := range logs {
+				require.Equal(t, tc.expLogs[i], log.BlockNumber)
+				require.Equal(t, tc.expTopic0[i], log.Topics[0])
+			}
+		})
+	}
+
+	// a gap restarts the indexed range
+	height := int64(indexer.BloomBitsSectionSize)
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, results[height]))
+	first, last, err = idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, height, first)
+	require.Equal(t, height, last)
+
+	logs, err := idxer.GetLogs(1, height, nil, [][]common.Hash{{topic3}}, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, uint64(height), logs[0].BlockNumber)
+}
+
+func TestKVIndexerMaintenance(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// buildBlock returns a block with an eth tx emitting a log of the address
+	buildBlock := func(height int64, gasUsed int64, address common.Address) (*tmtypes.Block, []*abci.ResponseDeliverTx, common.Hash) {
+		to := common.BigToAddress(big.NewInt(1))
+		tx := types.NewTx(&types.EvmTxArgs{Nonce: uint64(height), To: &to, Amount: big.NewInt(1000), GasLimit: 30000})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		log, err := json.Marshal(types.NewLogFromEth(&ethtypes.Log{Address: address, TxHash: txHash, BlockNumber: uint64(height)}))
+		require.NoError(t, err)
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		results := []*abci.ResponseDeliverTx{
+			{
+				Code:    0,
+				GasUsed: gasUsed,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+						{Key: types.AttributeKeyTxLog, Value: string(log)},
+					}},
+				},
+			},
+		}
+		return block, results, txHash
+	}
+
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+
+	t.Run("verify", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+		block, results, txHash := buildBlock(1, 21000, addr1)
+		require.NoError(t, idxer.IndexBlock(block, results))
+
+		mismatches, err := idxer.VerifyBlock(block, results)
+		require.NoError(t, err)
+		require.Empty(t, mismatches)
+
+		// different gas used
+		_, otherResults, _ := buildBlock(1, 25000, addr1)
+		mismatches, err = idxer.VerifyBlock(block, otherResults)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		require.Equal(t, txHash, mismatches[0].TxHash)
+		require.Equal(t, uint64(21000), mismatches[0].Indexed.GasUsed)
+		require.Equal(t, uint64(25000), mismatches[0].Expected.GasUsed)
+
+		// tx not in the block
+		mismatches, err = idxer.VerifyBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 1}}, nil)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		require.Nil(t, mismatches[0].Expected)
+
+		// tx not indexed
+		block2, results2, txHash2 := buildBlock(2, 21000, addr1)
+		mismatches, err = idxer.VerifyBlock(block2, results2)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		require.Equal(t, txHash2, mismatches[0].TxHash)
+		require.Nil(t, mismatches[0].Indexed)
+	})
+
+	t.Run("reindex", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+		block, results, txHash := buildBlock(1, 21000, addr1)
+		require.NoError(t, idxer.IndexBlock(block, results))
+
+		_, newResults, _ := buildBlock(1, 25000, addr2)
+		require.NoError(t, idxer.ReindexBlock(block, newResults))
+
+		res, err := idxer.GetByTxHash(txHash)
+		require.NoError(t, err)
+		require.Equal(t, uint64(25000), res.GasUsed)
+		mismatches, err := idxer.VerifyBlock(block, newResults)
+		require.NoError(t, err)
+		require.Empty(t, mismatches)
+
+		// the logs of the previous indexing are dropped
+		logs, err := idxer.GetLogs(1, 1, []common.Address{addr1}, nil, 10)
+		require.NoError(t, err)
+		require.Empty(t, logs)
+		logs, err = idxer.GetLogs(1, 1, []common.Address{addr2}, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		logs, err = idxer.GetLogs(1, 1, nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+		txHashes := make([]common.Hash, 4)
+		for height := int64(1); height <= 3; height++ {
+			block, results, txHash := buildBlock(height, 21000, addr1)
+			require.NoError(t, idxer.IndexBlock(block, results))
+			txHashes[height] = txHash
+		}
+
+		require.NoError(t, idxer.PruneBlocks(3))
+
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+		_, err = idxer.GetByTxHash(txHashes[1])
+		require.Error(t, err)
+		_, err = idxer.GetByBlockAndIndex(2, 0)
+		require.Error(t, err)
+		res, err := idxer.GetByTxHash(txHashes[3])
+		require.NoError(t, err)
+		require.Equal(t, int64(3), res.Height)
+
+		first, last, err := idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+		require.Equal(t, int64(3), last)
+		logs, err := idxer.GetLogs(1, 3, []common.Address{addr1}, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		require.Equal(t, uint64(3), logs[0].BlockNumber)
+
+		var count int
+		err = idxer.IterateTxResults(1, 3, func(common.Hash, *evertypes.TxResult) error {
+			count++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		// pruning all the blocks empties the db
+		require.NoError(t, idxer.PruneBlocks(4))
+		last, err = idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), last)
+		first, _, err = idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+	})
+
+	t.Run("skipped blocks", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+		require.NoError(t, idxer.MarkBlockSkipped(3))
+		require.NoError(t, idxer.MarkBlockSkipped(1))
+		require.NoError(t, idxer.MarkBlockSkipped(3))
+
+		skipped, err := idxer.SkippedBlocks()
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 3}, skipped)
+
+		// indexing the block clears the mark
+		block, results, _ := buildBlock(1, 21000, addr1)
+		require.NoError(t, idxer.IndexBlock(block, results))
+		skipped, err = idxer.SkippedBlocks()
+		require.NoError(t, err)
+		require.Equal(t, []int64{3}, skipped)
+	})
+}
@@ -0,0 +1,178 @@
+package indexer
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evertypes "github.com/servprotocolorg/serv/v12/types"
+)
+
+// TxResultMismatch is a difference between the tx result indexed for an eth tx and the one
+// built from the block results.
+type TxResultMismatch struct {
+	TxHash common.Hash
+	// Indexed is the indexed tx result, nil if the tx is not indexed.
+	Indexed *evertypes.TxResult
+	// Expected is the tx result built from the block results, nil if the tx is not in the block.
+	Expected *evertypes.TxResult
+}
+
+func (m TxResultMismatch) String() string {
+	switch {
+	case m.Indexed == nil:
+		return fmt.Sprintf("tx %s not indexed, expected: %s", m.TxHash.Hex(), m.Expected)
+	case m.Expected == nil:
+		return fmt.Sprintf("tx %s not in the block %d, indexed: %s", m.TxHash.Hex(), m.Indexed.Height, m.Indexed)
+	default:
+		return fmt.Sprintf("tx %s indexed: %s, expected: %s", m.TxHash.Hex(), m.Indexed, m.Expected)
+	}
+}
+
+// PruneBlocks deletes the entries of the blocks below the given one.
+func (kv *KVIndexer) PruneBlocks(before int64) error {
+	first, err := kv.firstEntryBlock()
+	if err != nil {
+		return err
+	}
+	if first == -1 || first >= before {
+		return nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	bloomBits := newBloomBitsWriter(kv.db)
+	if err := kv.deleteBlocks(batch, bloomBits, first, before-1); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d", before)
+	}
+	if err := bloomBits.write(batch); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d", before)
+	}
+	if err := pruneLogIndexedRange(kv.db, batch, before); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d", before)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, write batch", before)
+	}
+	return nil
+}
+
+// ReindexBlock drops the entries indexed for the block and indexes it again.
+func (kv *KVIndexer) ReindexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	return kv.indexBlock(block, txResults, true)
+}
+
+// IterateTxResults calls the callback with the indexed tx results of the [from, to] block
+// range, ordered by block and eth tx index. The iteration stops at the first callback error.
+func (kv *KVIndexer) IterateTxResults(from, to int64, cb func(common.Hash, *evertypes.TxResult) error) error {
+	it, err := kv.db.Iterator(txIndexBlockPrefix(from), txIndexBlockPrefix(to+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "IterateTxResults")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		txHash := common.BytesToHash(it.Value())
+		txResult, err := kv.getByTxHash(txHash)
+		if err != nil {
+			return err
+		}
+		if err := cb(txHash, txResult); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// VerifyBlock cross-checks the tx results indexed for the block against the ones built from the
+// block results, and returns the mismatches.
+func (kv *KVIndexer) VerifyBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) ([]TxResultMismatch, error) {
+	height := block.Header.Height
+
+	expected := make(map[common.Hash]*evertypes.TxResult)
+	ethTxs := parseEthTxs(kv.clientCtx.TxConfig.TxDecoder(), kv.logger, block, txResults)
+	for _, tx := range ethTxs {
+		expected[tx.Hash] = &tx.Result
+	}
+
+	var mismatches []TxResultMismatch
+	indexed := make(map[common.Hash]bool)
+	err := kv.IterateTxResults(height, height, func(txHash common.Hash, txResult *evertypes.TxResult) error {
+		indexed[txHash] = true
+		expTxResult, ok := expected[txHash]
+		if !ok || *expTxResult != *txResult {
+			mismatches = append(mismatches, TxResultMismatch{TxHash: txHash, Indexed: txResult, Expected: expTxResult})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+
+	for _, tx := range ethTxs {
+		if indexed[tx.Hash] {
+			continue
+		}
+		// the tx might be indexed at another block or eth tx index
+		txResult, err := kv.getByTxHash(tx.Hash)
+		if err != nil {
+			txResult = nil
+		}
+		mismatches = append(mismatches, TxResultMismatch{TxHash: tx.Hash, Indexed: txResult, Expected: &tx.Result})
+	}
+	return mismatches, nil
+}
+
+// deleteBlocks deletes the tx results and the logs indexed for the [from, to] block range.
+func (kv *KVIndexer) deleteBlocks(batch dbm.Batch, bloomBits *bloomBitsWriter, from, to int64) error {
+	it, err := kv.db.Iterator(txIndexBlockPrefix(from), txIndexBlockPrefix(to+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "deleteBlocks")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(it.Value()))); err != nil {
+			return errorsmod.Wrap(err, "delete tx-hash key")
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete tx-index key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	return kv.deleteLogs(batch, bloomBits, from, to)
+}
+
+// firstEntryBlock returns the first block with tx results or logs indexed, returns -1 if db is empty.
+func (kv *KVIndexer) firstEntryBlock() (int64, error) {
+	first, err := LoadFirstBlock(kv.db)
+	if err != nil {
+		return 0, err
+	}
+
+	it, err := kv.db.Iterator([]byte{KeyPrefixLog}, []byte{KeyPrefixLog + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "firstEntryBlock")
+	}
+	defer it.Close()
+	if it.Valid() {
+		height := int64(sdk.BigEndianToUint64(it.Key()[1:9]))
+		if first == -1 || height < first {
+			first = height
+		}
+	}
+	return first, nil
+}
+
+func txIndexBlockPrefix(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixTxIndex}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
@@ -0,0 +1,600 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+const (
+	KeyPrefixLog        = 3
+	KeyPrefixLogAddress = 4
+	KeyPrefixLogTopic   = 5
+	KeyPrefixBloomBits  = 6
+	KeyPrefixLogRange   = 7
+
+	// BloomBitsSectionSize is the number of blocks of a bloom bits section, each bit of the
+	// blocks bloom is stored as a bit vector of the blocks of the section.
+	BloomBitsSectionSize = 4096
+
+	// bloomBitLength is the number of bits of a bloom filter.
+	bloomBitLength = ethtypes.BloomByteLength * 8
+)
+
+// LogKey returns the key for db entry: `(block number, log position) -> log`
+func LogKey(blockNumber int64, position uint64) []byte {
+	return append(logBlockPrefix(blockNumber), sdk.Uint64ToBigEndian(position)...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log position) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, position uint64) []byte {
+	return append(logAddressBlockPrefix(address, blockNumber), sdk.Uint64ToBigEndian(position)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic index, topic, block number, log position) -> nil`
+func LogTopicKey(index int, topic common.Hash, blockNumber int64, position uint64) []byte {
+	return append(logTopicBlockPrefix(index, topic, blockNumber), sdk.Uint64ToBigEndian(position)...)
+}
+
+// BloomBitsKey returns the key for db entry: `(bloom bit, section) -> bit vector of the section blocks`
+func BloomBitsKey(bit uint, section uint64) []byte {
+	key := []byte{KeyPrefixBloomBits, byte(bit >> 8), byte(bit)}
+	return append(key, sdk.Uint64ToBigEndian(section)...)
+}
+
+func logBlockPrefix(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLog}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+func logAddressBlockPrefix(address common.Address, blockNumber int64) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+func logTopicBlockPrefix(index int, topic common.Hash, blockNumber int64) []byte {
+	key := append([]byte{KeyPrefixLogTopic, byte(index)}, topic.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// LogIndexedRange returns the first and last blocks of the contiguous range whose logs are
+// indexed. Returns -1, -1 if no logs are indexed.
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	return LoadLogIndexedRange(kv.db)
+}
+
+// GetLogs returns the logs of the [from, to] block range matching the addresses and topics
+// criteria, it fails if more than limit logs match. The candidate blocks are selected by the
+// bloom bits sections, then the logs of each block by the address or topic index.
+func (kv *KVIndexer) GetLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	if from > to {
+		return logs, nil
+	}
+
+	clauses := bloomClauses(addresses, topics)
+	if len(clauses) == 0 {
+		// no criteria besides the number of topics, all the logs of the range are candidates
+		it, err := kv.db.Iterator(logBlockPrefix(from), logBlockPrefix(to+1))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			log, err := kv.decodeLog(it.Value())
+			if err != nil {
+				return nil, err
+			}
+			if !matchLog(log, addresses, topics) {
+				continue
+			}
+			if len(logs) >= limit {
+				return nil, fmt.Errorf("query returned more than %d results", limit)
+			}
+			logs = append(logs, log)
+		}
+		return logs, it.Error()
+	}
+
+	heights, err := kv.candidateBlocks(from, to, clauses)
+	if err != nil {
+		return nil, err
+	}
+	for _, height := range heights {
+		blockLogs, err := kv.blockLogs(height, addresses, topics)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs)+len(blockLogs) > limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, blockLogs...)
+	}
+	return logs, nil
+}
+
+// blockLogs returns the logs of a block matching the criteria, the logs are looked up by the
+// address index, or by the index of the first topic criterion if there are no addresses.
+func (kv *KVIndexer) blockLogs(height int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, error) {
+	var prefixes [][]byte
+	if len(addresses) > 0 {
+		for _, address := range addresses {
+			prefixes = append(prefixes, logAddressBlockPrefix(address, height))
+		}
+	} else {
+		for i, sub := range topics {
+			if len(sub) == 0 {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, logTopicBlockPrefix(i, topic, height))
+			}
+			break
+		}
+	}
+
+	positions := make(map[uint64]bool)
+	for _, prefix := range prefixes {
+		it, err := dbm.IteratePrefix(kv.db, prefix)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "blockLogs")
+		}
+		for ; it.Valid(); it.Next() {
+			positions[sdk.BigEndianToUint64(it.Key()[len(prefix):])] = true
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sorted := make([]uint64, 0, len(positions))
+	for position := range positions {
+		sorted = append(sorted, position)
+	}
+	sortUint64s(sorted)
+
+	logs := make([]*ethtypes.Log, 0, len(sorted))
+	for _, position := range sorted {
+		bz, err := kv.db.Get(LogKey(height, position))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "blockLogs %d", height)
+		}
+		if len(bz) == 0 {
+			return nil, fmt.Errorf("log not found, block: %d, position: %d", height, position)
+		}
+		log, err := kv.decodeLog(bz)
+		if err != nil {
+			return nil, err
+		}
+		if matchLog(log, addresses, topics) {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+// candidateBlocks returns the blocks of the [from, to] range whose bloom matches all the
+// clauses, according to the bloom bits sections.
+func (kv *KVIndexer) candidateBlocks(from, to int64, clauses [][][]byte) ([]int64, error) {
+	var heights []int64
+	for section := uint64(from) / BloomBitsSectionSize; section <= uint64(to)/BloomBitsSectionSize; section++ {
+		var matches []byte
+		for _, clause := range clauses {
+			clauseMatches := make([]byte, BloomBitsSectionSize/8)
+			for _, value := range clause {
+				valueMatches, err := kv.bloomBitsMatches(section, bloomBitPositions(value))
+				if err != nil {
+					return nil, err
+				}
+				orBits(clauseMatches, valueMatches)
+			}
+			if matches == nil {
+				matches = clauseMatches
+			} else {
+				andBits(matches, clauseMatches)
+			}
+		}
+
+		for i := 0; i < BloomBitsSectionSize; i++ {
+			if matches[i/8]&(1<<(7-uint(i%8))) == 0 {
+				continue
+			}
+			height := int64(section*BloomBitsSectionSize) + int64(i)
+			if height >= from && height <= to {
+				heights = append(heights, height)
+			}
+		}
+	}
+	return heights, nil
+}
+
+// bloomBitsMatches returns the bit vector of the section blocks whose bloom has all the bits set.
+func (kv *KVIndexer) bloomBitsMatches(section uint64, bits [3]uint) ([]byte, error) {
+	var matches []byte
+	for _, bit := range bits {
+		vector, err := kv.db.Get(BloomBitsKey(bit, section))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "bloom bits %d, section %d", bit, section)
+		}
+		if len(vector) == 0 {
+			return make([]byte, BloomBitsSectionSize/8), nil
+		}
+		if matches == nil {
+			matches = append([]byte{}, vector...)
+		} else {
+			andBits(matches, vector)
+		}
+	}
+	return matches, nil
+}
+
+func (kv *KVIndexer) decodeLog(bz []byte) (*ethtypes.Log, error) {
+	var log evmtypes.Log
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+		return nil, errorsmod.Wrap(err, "decode log")
+	}
+	return log.ToEthereum(), nil
+}
+
+// indexLogs indexes the logs of the block by position, address and topics, and sets the block
+// bits in the bloom bits section.
+func (kv *KVIndexer) indexLogs(batch dbm.Batch, bloomBits *bloomBitsWriter, height int64, txResults []*abci.ResponseDeliverTx) error {
+	var (
+		bloom    ethtypes.Bloom
+		position uint64
+	)
+	for _, result := range txResults {
+		logs, err := txLogsFromEvents(result.Events)
+		if err != nil {
+			kv.logger.Error("Fail to parse tx logs", "err", err, "block", height)
+			continue
+		}
+
+		for _, log := range logs {
+			if err := batch.Set(LogKey(height, position), kv.clientCtx.Codec.MustMarshal(log)); err != nil {
+				return errorsmod.Wrap(err, "set log key")
+			}
+
+			address := common.HexToAddress(log.Address)
+			if err := batch.Set(LogAddressKey(address, height, position), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log address key")
+			}
+			bloom.Add(address.Bytes())
+
+			for i, t := range log.Topics {
+				topic := common.HexToHash(t)
+				if err := batch.Set(LogTopicKey(i, topic, height, position), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log topic key")
+				}
+				bloom.Add(topic.Bytes())
+			}
+			position++
+		}
+	}
+
+	return bloomBits.setBlockBloom(height, bloom, true)
+}
+
+// deleteLogs deletes the logs of the [from, to] block range with their address and topic
+// entries, and clears the blocks bits in the bloom bits sections.
+func (kv *KVIndexer) deleteLogs(batch dbm.Batch, bloomBits *bloomBitsWriter, from, to int64) error {
+	it, err := kv.db.Iterator(logBlockPrefix(from), logBlockPrefix(to+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "deleteLogs")
+	}
+	defer it.Close()
+
+	blooms := make(map[int64]*ethtypes.Bloom)
+	var heights []int64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		height := int64(sdk.BigEndianToUint64(key[1:9]))
+		position := sdk.BigEndianToUint64(key[9:])
+		log, err := kv.decodeLog(it.Value())
+		if err != nil {
+			return err
+		}
+
+		bloom, ok := blooms[height]
+		if !ok {
+			bloom = &ethtypes.Bloom{}
+			blooms[height] = bloom
+			heights = append(heights, height)
+		}
+		if err := batch.Delete(LogAddressKey(log.Address, height, position)); err != nil {
+			return errorsmod.Wrap(err, "delete log address key")
+		}
+		bloom.Add(log.Address.Bytes())
+		for i, topic := range log.Topics {
+			if err := batch.Delete(LogTopicKey(i, topic, height, position)); err != nil {
+				return errorsmod.Wrap(err, "delete log topic key")
+			}
+			bloom.Add(topic.Bytes())
+		}
+		if err := batch.Delete(key); err != nil {
+			return errorsmod.Wrap(err, "delete log key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	for _, height := range heights {
+		if err := bloomBits.setBlockBloom(height, *blooms[height], false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bloomBitsWriter updates the bloom bits section vectors, it caches the updated vectors so the
+// updates of several blocks can be written in the same batch.
+type bloomBitsWriter struct {
+	db      dbm.DB
+	vectors map[string][]byte
+	keys    []string
+}
+
+func newBloomBitsWriter(db dbm.DB) *bloomBitsWriter {
+	return &bloomBitsWriter{db: db, vectors: make(map[string][]byte)}
+}
+
+// setBlockBloom sets, or clears, the bit of the block in the bloom bits section vectors of the
+// bits set in the block bloom.
+func (w *bloomBitsWriter) setBlockBloom(height int64, bloom ethtypes.Bloom, set bool) error {
+	section := uint64(height) / BloomBitsSectionSize
+	offset := uint64(height) % BloomBitsSectionSize
+	for bit := uint(0); bit < bloomBitLength; bit++ {
+		if bloom[bit/8]&(1<<(bit%8)) == 0 {
+			continue
+		}
+
+		vector, err := w.vector(BloomBitsKey(bit, section))
+		if err != nil {
+			return errorsmod.Wrapf(err, "bloom bits %d, section %d", bit, section)
+		}
+		if set {
+			vector[offset/8] |= 1 << (7 - offset%8)
+		} else {
+			vector[offset/8] &^= 1 << (7 - offset%8)
+		}
+	}
+	return nil
+}
+
+func (w *bloomBitsWriter) vector(key []byte) ([]byte, error) {
+	if vector, ok := w.vectors[string(key)]; ok {
+		return vector, nil
+	}
+
+	vector, err := w.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(vector) == 0 {
+		vector = make([]byte, BloomBitsSectionSize/8)
+	} else {
+		vector = append([]byte{}, vector...)
+	}
+	w.vectors[string(key)] = vector
+	w.keys = append(w.keys, string(key))
+	return vector, nil
+}
+
+// write writes the updated vectors into the batch, the empty vectors are deleted.
+func (w *bloomBitsWriter) write(batch dbm.Batch) error {
+	for _, key := range w.keys {
+		vector := w.vectors[key]
+		if isZeroBits(vector) {
+			if err := batch.Delete([]byte(key)); err != nil {
+				return errorsmod.Wrap(err, "delete bloom bits key")
+			}
+			continue
+		}
+		if err := batch.Set([]byte(key), vector); err != nil {
+			return errorsmod.Wrap(err, "set bloom bits key")
+		}
+	}
+	return nil
+}
+
+// updateLogIndexedRange extends the contiguous range of blocks whose logs are indexed with the
+// given block. The range restarts from the block if it's not contiguous with the blocks above.
+func updateLogIndexedRange(db dbm.DB, batch dbm.Batch, height int64) error {
+	first, last, err := LoadLogIndexedRange(db)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case first == -1 || height > last+1:
+		first, last = height, height
+	case height == last+1:
+		last = height
+	case height == first-1:
+		first = height
+	default:
+		// already covered or not contiguous with the range below
+		return nil
+	}
+
+	return setLogIndexedRange(batch, first, last)
+}
+
+// pruneLogIndexedRange removes the blocks below the given one from the range of blocks whose
+// logs are indexed.
+func pruneLogIndexedRange(db dbm.DB, batch dbm.Batch, before int64) error {
+	first, last, err := LoadLogIndexedRange(db)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case first == -1 || first >= before:
+		return nil
+	case last < before:
+		if err := batch.Delete([]byte{KeyPrefixLogRange}); err != nil {
+			return errorsmod.Wrap(err, "delete log range key")
+		}
+		return nil
+	default:
+		return setLogIndexedRange(batch, before, last)
+	}
+}
+
+func setLogIndexedRange(batch dbm.Batch, first, last int64) error {
+	bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)
+	if err := batch.Set([]byte{KeyPrefixLogRange}, bz); err != nil {
+		return errorsmod.Wrap(err, "set log range key")
+	}
+	return nil
+}
+
+// LoadLogIndexedRange loads the first and last blocks of the contiguous range whose logs are
+// indexed, returns -1, -1 if no logs are indexed.
+func LoadLogIndexedRange(db dbm.DB) (int64, int64, error) {
+	bz, err := db.Get([]byte{KeyPrefixLogRange})
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LoadLogIndexedRange")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log range length, expect: 16, got: %d", len(bz))
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil
+}
+
+// txLogsFromEvents parses the logs of all the eth msgs of a tx from its events.
+func txLogsFromEvents(events []abci.Event) ([]*evmtypes.Log, error) {
+	var logs []*evmtypes.Log
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+			var log evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &log)
+		}
+	}
+	return logs, nil
+}
+
+// bloomClauses returns the values of the criteria that must be in the bloom of a matching
+// block: one of the addresses and one of the topics of each position.
+func bloomClauses(addresses []common.Address, topics [][]common.Hash) [][][]byte {
+	var clauses [][][]byte
+	if len(addresses) > 0 {
+		clause := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			clause[i] = address.Bytes()
+		}
+		clauses = append(clauses, clause)
+	}
+	for _, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		clause := make([][]byte, len(sub))
+		for i, topic := range sub {
+			clause[i] = topic.Bytes()
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses
+}
+
+// bloomBitPositions returns the positions, in the bloom byte array, of the 3 bits set by the value.
+func bloomBitPositions(value []byte) [3]uint {
+	hash := crypto.Keccak256(value)
+
+	var positions [3]uint
+	for i := range positions {
+		bit := (uint(hash[2*i])<<8 | uint(hash[2*i+1])) & (bloomBitLength - 1)
+		positions[i] = (ethtypes.BloomByteLength-1-bit/8)*8 + bit%8
+	}
+	return positions
+}
+
+// matchLog returns true if the log matches the addresses and the positional topics criteria.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !containsAddress(addresses, log.Address) {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		match := false
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func isZeroBits(bits []byte) bool {
+	for _, b := range bits {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func andBits(dst, src []byte) {
+	for i := range dst {
+		dst[i] &= src[i]
+	}
+}
+
+func orBits(dst, src []byte) {
+	for i := range dst {
+		dst[i] |= src[i]
+	}
+}
+
+func sortUint64s(values []uint64) {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+}
@@ -0,0 +1,353 @@
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evertypes "github.com/servprotocolorg/serv/v12/types"
+)
+
+// SQLDialect is the SQL database flavour of the SQLIndexer.
+type SQLDialect string
+
+const (
+	DialectPostgres SQLDialect = "postgres"
+	DialectSQLite   SQLDialect = "sqlite"
+)
+
+// DriverName returns the name of the database/sql driver of the dialect, the driver must be
+// registered by the binary: lib/pq registers `postgres` and modernc.org/sqlite registers `sqlite`.
+func (d SQLDialect) DriverName() string {
+	return string(d)
+}
+
+// Validate returns an error if the dialect is not supported.
+func (d SQLDialect) Validate() error {
+	switch d {
+	case DialectPostgres, DialectSQLite:
+		return nil
+	default:
+		return fmt.Errorf("unsupported sql dialect: %s", d)
+	}
+}
+
+// sqlSchema creates the tables of the indexer, the hashes and addresses are stored as lower
+// case hex strings and the values as decimal strings to keep the schema portable.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS evm_blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		eth_tx_count INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS evm_txs (
+		hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		tx_index BIGINT NOT NULL,
+		msg_index BIGINT NOT NULL,
+		eth_tx_index BIGINT NOT NULL,
+		failed BOOLEAN NOT NULL,
+		gas_used BIGINT NOT NULL,
+		cumulative_gas_used BIGINT NOT NULL,
+		sender TEXT NOT NULL,
+		recipient TEXT,
+		nonce BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		UNIQUE (height, eth_tx_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_txs_sender ON evm_txs (sender)`,
+	`CREATE INDEX IF NOT EXISTS evm_txs_recipient ON evm_txs (recipient)`,
+	`CREATE TABLE IF NOT EXISTS evm_logs (
+		height BIGINT NOT NULL,
+		log_index BIGINT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		tx_index BIGINT NOT NULL,
+		address TEXT NOT NULL,
+		topic0 TEXT,
+		topic1 TEXT,
+		topic2 TEXT,
+		topic3 TEXT,
+		data TEXT NOT NULL,
+		PRIMARY KEY (height, log_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_tx_hash ON evm_logs (tx_hash)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_address ON evm_logs (address)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_topic0 ON evm_logs (topic0)`,
+	`CREATE TABLE IF NOT EXISTS evm_skipped_blocks (
+		height BIGINT PRIMARY KEY
+	)`,
+}
+
+var _ evertypes.EVMTxIndexer = &SQLIndexer{}
+
+// SQLIndexer implements an ETH-Tx indexer on a relational database, it stores the eth txs
+// with their receipt fields and the logs so they can also be queried by external tools.
+type SQLIndexer struct {
+	db        *sql.DB
+	dialect   SQLDialect
+	logger    log.Logger
+	clientCtx client.Context
+
+	mu                      *sync.RWMutex
+	ready                   bool
+	lastRequestIndexedBlock int64
+}
+
+// NewSQLIndexer creates the SQLIndexer and its tables if they don't exist.
+func NewSQLIndexer(db *sql.DB, dialect SQLDialect, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if err := dialect.Validate(); err != nil {
+		return nil, err
+	}
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errorsmod.Wrap(err, "create indexer schema")
+		}
+	}
+
+	return &SQLIndexer{
+		db:                      db,
+		dialect:                 dialect,
+		logger:                  logger,
+		clientCtx:               clientCtx,
+		lastRequestIndexedBlock: -1,
+
+		mu:    &sync.RWMutex{},
+		ready: false,
+	}, nil
+}
+
+// IndexBlock indexes all ETH Txs of the block and their logs, in a single database transaction.
+func (si *SQLIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin transaction", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	ethTxs := parseEthTxs(si.clientCtx.TxConfig.TxDecoder(), si.logger, block, txResults)
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO evm_blocks (height, hash, eth_tx_count) VALUES (?, ?, ?) ON CONFLICT (height) DO NOTHING`),
+		height, hexutil.Encode(block.Hash()), len(ethTxs),
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+
+	for _, tx := range ethTxs {
+		if err := si.insertTx(dbTx, tx); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+
+	var position int64
+	for txIndex, result := range txResults {
+		logs, err := txLogsFromEvents(result.Events)
+		if err != nil {
+			si.logger.Error("Fail to parse tx logs", "err", err, "block", height)
+			continue
+		}
+		for _, log := range logs {
+			topics := make([]interface{}, 4)
+			for i := range topics {
+				if i < len(log.Topics) {
+					topics[i] = strings.ToLower(log.Topics[i])
+				}
+			}
+			if _, err := dbTx.Exec(
+				si.rebind(`INSERT INTO evm_logs (height, log_index, tx_hash, tx_index, address, topic0, topic1, topic2, topic3, data)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (height, log_index) DO NOTHING`),
+				height, position, strings.ToLower(log.TxHash), txIndex, strings.ToLower(log.Address),
+				topics[0], topics[1], topics[2], topics[3], hexutil.Encode(log.Data),
+			); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d, insert log", height)
+			}
+			position++
+		}
+	}
+
+	if _, err := dbTx.Exec(si.rebind(`DELETE FROM evm_skipped_blocks WHERE height = ?`), height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, delete skipped block", height)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit transaction", height)
+	}
+
+	si.mu.Lock()
+	defer si.mu.Unlock()
+	if si.lastRequestIndexedBlock < height {
+		si.lastRequestIndexedBlock = height
+	}
+
+	return nil
+}
+
+// insertTx inserts the eth tx and its result.
+func (si *SQLIndexer) insertTx(dbTx *sql.Tx, tx *ethTx) error {
+	ethereumTx := tx.Msg.AsTransaction()
+
+	var recipient interface{}
+	if to := ethereumTx.To(); to != nil {
+		recipient = hexutil.Encode(to.Bytes())
+	}
+
+	res := tx.Result
+	_, err := dbTx.Exec(
+		si.rebind(`INSERT INTO evm_txs (hash, height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used,
+			sender, recipient, nonce, value, gas_limit) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (hash) DO NOTHING`),
+		tx.Hash.Hex(), res.Height, res.TxIndex, res.MsgIndex, res.EthTxIndex, res.Failed, res.GasUsed, res.CumulativeGasUsed,
+		strings.ToLower(tx.Msg.From), recipient, ethereumTx.Nonce(), ethereumTx.Value().String(), ethereumTx.Gas(),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "insert tx %s", tx.Hash.Hex())
+	}
+	return nil
+}
+
+func (si *SQLIndexer) Ready() {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+	si.ready = true
+}
+
+func (si *SQLIndexer) IsReady() bool {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+	return si.ready
+}
+
+// LastIndexedBlock returns the last block number which was indexed and committed into database.
+// Returns -1 if db is empty.
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.loadBlock(`SELECT MAX(height) FROM evm_txs`)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.loadBlock(`SELECT MIN(height) FROM evm_txs`)
+}
+
+// GetLastRequestIndexedBlock returns the block height of the latest success called to IndexBlock()
+func (si *SQLIndexer) GetLastRequestIndexedBlock() (int64, error) {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	if si.lastRequestIndexedBlock == -1 {
+		return si.LastIndexedBlock()
+	}
+
+	return si.lastRequestIndexedBlock, nil
+}
+
+// MarkBlockSkipped persists a block the indexer failed to index, the mark is cleared when the
+// block is indexed.
+func (si *SQLIndexer) MarkBlockSkipped(height int64) error {
+	_, err := si.db.Exec(si.rebind(`INSERT INTO evm_skipped_blocks (height) VALUES (?) ON CONFLICT (height) DO NOTHING`), height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "MarkBlockSkipped %d", height)
+	}
+	return nil
+}
+
+// SkippedBlocks returns the blocks marked as skipped in ascending order.
+func (si *SQLIndexer) SkippedBlocks() ([]int64, error) {
+	rows, err := si.db.Query(`SELECT height FROM evm_skipped_blocks ORDER BY height`)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "SkippedBlocks")
+	}
+	defer rows.Close()
+
+	heights := []int64{}
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, errorsmod.Wrap(err, "SkippedBlocks")
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*evertypes.TxResult, error) {
+	res, err := si.queryTxResult(`WHERE hash = ?`, hash.Hex())
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*evertypes.TxResult, error) {
+	res, err := si.queryTxResult(`WHERE height = ? AND eth_tx_index = ?`, blockNumber, txIndex)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+func (si *SQLIndexer) queryTxResult(where string, args ...interface{}) (*evertypes.TxResult, error) {
+	var res evertypes.TxResult
+	err := si.db.QueryRow(
+		si.rebind(`SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used FROM evm_txs `+where),
+		args...,
+	).Scan(&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &res.Failed, &res.GasUsed, &res.CumulativeGasUsed)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (si *SQLIndexer) loadBlock(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "load indexed block")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// rebind replaces the `?` placeholders of the query by the placeholders of the dialect.
+func (si *SQLIndexer) rebind(query string) string {
+	return rebindQuery(si.dialect, query)
+}
+
+func rebindQuery(dialect SQLDialect, query string) string {
+	if dialect != DialectPostgres {
+		return query
+	}
+
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, c := range query {
+		if c != '?' {
+			b.WriteRune(c)
+			continue
+		}
+		n++
+		b.WriteString("$" + strconv.Itoa(n))
+	}
+	return b.String()
+}
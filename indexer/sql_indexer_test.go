@@ -0,0 +1,159 @@
+package indexer
+
+import (
+	"database/sql"
+	"math/big"
+	"os"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/servprotocolorg/serv/v12/app"
+	"github.com/servprotocolorg/serv/v12/constants"
+	"github.com/servprotocolorg/serv/v12/crypto/ethsecp256k1"
+	evmenc "github.com/servprotocolorg/serv/v12/encoding"
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// postgresDSNEnv is the environment variable of the PostgreSQL database the SQL indexer is
+// tested against, the PostgreSQL test is skipped if it's not set.
+const postgresDSNEnv = "SERV_INDEXER_POSTGRES_DSN"
+
+func TestRebindQuery(t *testing.T) {
+	query := `SELECT hash FROM evm_txs WHERE height = ? AND eth_tx_index = ?`
+	require.Equal(t, query, rebindQuery(DialectSQLite, query))
+	require.Equal(t, `SELECT hash FROM evm_txs WHERE height = $1 AND eth_tx_index = $2`, rebindQuery(DialectPostgres, query))
+}
+
+func TestSQLDialect(t *testing.T) {
+	require.NoError(t, DialectPostgres.Validate())
+	require.NoError(t, DialectSQLite.Validate())
+	require.Error(t, SQLDialect("mysql").Validate())
+	require.Equal(t, "postgres", DialectPostgres.DriverName())
+	require.Equal(t, "sqlite", DialectSQLite.DriverName())
+}
+
+func TestSQLIndexer(t *testing.T) {
+	t.Run("sqlite", func(t *testing.T) {
+		db, err := sql.Open(DialectSQLite.DriverName(), ":memory:")
+		require.NoError(t, err)
+		defer db.Close()
+		// every connection opens its own in-memory database
+		db.SetMaxOpenConns(1)
+
+		testSQLIndexer(t, db, DialectSQLite)
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		dsn := os.Getenv(postgresDSNEnv)
+		if dsn == "" {
+			t.Skipf("%s is not set", postgresDSNEnv)
+		}
+		db, err := sql.Open(DialectPostgres.DriverName(), dsn)
+		require.NoError(t, err)
+		defer db.Close()
+		for _, table := range []string{"evm_blocks", "evm_txs", "evm_logs", "evm_skipped_blocks"} {
+			_, err := db.Exec("DROP TABLE IF EXISTS " + table)
+			require.NoError(t, err)
+		}
+
+		testSQLIndexer(t, db, DialectPostgres)
+	})
+}
+
+// testSQLIndexer indexes a block with one eth tx and checks the results against the kv indexer.
+func testSQLIndexer(t *testing.T, db *sql.DB, dialect SQLDialect) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := evmenc.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	results := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyTxLog, Value: `{"address":"` + to.Hex() + `","topics":["` + txHash.Hex() + `"],"transactionHash":"` + txHash.Hex() + `"}`},
+				}},
+			},
+		},
+	}
+
+	idxer, err := NewSQLIndexer(db, dialect, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	require.NoError(t, idxer.MarkBlockSkipped(3))
+	require.NoError(t, idxer.MarkBlockSkipped(1))
+	require.NoError(t, idxer.MarkBlockSkipped(3))
+	skipped, err := idxer.SkippedBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3}, skipped)
+
+	require.NoError(t, idxer.IndexBlock(block, results))
+	// indexing the block clears the mark
+	skipped, err = idxer.SkippedBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, skipped)
+	// indexing a block again is a no-op
+	require.NoError(t, idxer.IndexBlock(block, results))
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+
+	// the results are the ones of the kv indexer
+	kvIdxer := NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, kvIdxer.IndexBlock(block, results))
+	expRes, err := kvIdxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, expRes, res)
+	res, err = idxer.GetByBlockAndIndex(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, expRes, res)
+
+	_, err = idxer.GetByTxHash(common.Hash{})
+	require.Error(t, err)
+	_, err = idxer.GetByBlockAndIndex(1, 1)
+	require.Error(t, err)
+
+	var count int
+	query := rebindQuery(dialect, `SELECT COUNT(*) FROM evm_logs WHERE tx_hash = ?`)
+	require.NoError(t, db.QueryRow(query, txHash.Hex()).Scan(&count))
+	require.Equal(t, 1, count)
+}
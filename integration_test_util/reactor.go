@@ -5,8 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
-	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
-	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	_ "github.com/servprotocolorg/serv/v12/x/evm/core/tracers/js"
+	_ "github.com/servprotocolorg/serv/v12/x/evm/core/tracers/native"
 )
 
 func init() {
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IBank contract's address.
+address constant BANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+/// @dev The IBank contract's instance.
+IBank constant BANK_CONTRACT = IBank(BANK_PRECOMPILE_ADDRESS);
+
+/// @title Bank precompiled contract
+/// @dev The interface through which solidity contracts interact with the bank module.
+interface IBank {
+    /// @dev Emitted when coins are sent from an account to another one.
+    /// @param from The address of the sender
+    /// @param to The address of the recipient
+    /// @param amount The coins sent
+    event Send(address indexed from, address indexed to, Coin[] amount);
+
+    /// @dev Sends coins from an account to another one. The caller can only send its own
+    /// coins, unless the sender granted it a bank send authorization.
+    /// @param from The address of the sender
+    /// @param to The address of the recipient
+    /// @param amount The coins to send
+    /// @return success Whether the coins were sent
+    function send(
+        address from,
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev Returns all the balances of an account.
+    /// @param account The address of the account
+    /// @return balances The balances of the account
+    function balances(address account) external view returns (Coin[] memory balances);
+
+    /// @dev Returns the total supply of a denomination.
+    /// @param denom The denomination
+    /// @return supply The total supply
+    function supplyOf(string calldata denom) external view returns (uint256 supply);
+
+    /// @dev Returns the total supply of all the denominations.
+    /// @return totalSupply The total supply
+    function totalSupply() external view returns (Coin[] memory totalSupply);
+}
@@ -0,0 +1,88 @@
+package bank
+
+import (
+	_ "embed" // embed the contract ABI
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+const (
+	// SendMethod defines the ABI method name of the bank send transaction.
+	SendMethod = "send"
+	// BalancesMethod defines the ABI method name of the balances query.
+	BalancesMethod = "balances"
+	// SupplyOfMethod defines the ABI method name of the supply query of a denomination.
+	SupplyOfMethod = "supplyOf"
+	// TotalSupplyMethod defines the ABI method name of the total supply query.
+	TotalSupplyMethod = "totalSupply"
+
+	// EventTypeSend defines the event emitted by the send transaction.
+	EventTypeSend = "Send"
+)
+
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// BankKeeper defines the expected bank keeper interface.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+}
+
+// Precompile is the stateful precompiled contract wrapping the bank module.
+type Precompile struct {
+	cmn.Precompile
+
+	bankKeeper  BankKeeper
+	authzKeeper cmn.AuthzKeeper
+}
+
+// NewPrecompile creates the bank precompiled contract.
+func NewPrecompile(bankKeeper BankKeeper, authzKeeper cmn.AuthzKeeper) (Precompile, error) {
+	contractABI, err := cmn.LoadABI(abiJSON)
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:  cmn.NewPrecompile(evmtypes.BankPrecompileAddress, contractABI),
+		bankKeeper:  bankKeeper,
+		authzKeeper: authzKeeper,
+	}, nil
+}
+
+// RunStateful executes the bank method called by the contract input.
+func (p Precompile) RunStateful(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunMethod(evm, contract, readOnly, p.execute)
+}
+
+func (p Precompile) execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	case SendMethod:
+		return p.Send(ctx, evm, contract, method, args)
+	case BalancesMethod:
+		return p.Balances(ctx, method, args)
+	case SupplyOfMethod:
+		return p.SupplyOf(ctx, method, args)
+	case TotalSupplyMethod:
+		return p.TotalSupply(ctx, method)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+}
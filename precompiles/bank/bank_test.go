@@ -0,0 +1,214 @@
+package bank_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/constants"
+	"github.com/servprotocolorg/serv/v12/precompiles/bank"
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/precompiles/testutil"
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+	evmkeeper "github.com/servprotocolorg/serv/v12/x/evm/keeper"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	precompile bank.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.PrecompileTestSuite.SetupTest()
+
+	precompile, err := bank.NewPrecompile(s.App.BankKeeper, s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+}
+
+func (s *PrecompileTestSuite) TestSend() {
+	var (
+		recipient = utiltx.GenerateAddress()
+		amount    = big.NewInt(100)
+		coins     = []cmn.Coin{{Denom: constants.BaseDenom, Amount: amount}}
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func() (sender, to, from common.Address)
+		expRevert bool
+	}{
+		{
+			"pass - sender sends its own coins",
+			func() (common.Address, common.Address, common.Address) {
+				return s.Address, s.precompile.Address(), s.Address
+			},
+			false,
+		},
+		{
+			"pass - contract sends its own coins",
+			func() (common.Address, common.Address, common.Address) {
+				forwarder := s.DeployForwarder(s.precompile.Address())
+				s.Require().NoError(s.App.BankKeeper.SendCoins(
+					s.Ctx, s.Address.Bytes(), forwarder.Bytes(), sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdk.NewIntFromBigInt(amount))),
+				))
+				return s.Address, forwarder, forwarder
+			},
+			false,
+		},
+		{
+			"fail - contract sends the coins of the sender without authorization",
+			func() (common.Address, common.Address, common.Address) {
+				return s.Address, s.DeployForwarder(s.precompile.Address()), s.Address
+			},
+			true,
+		},
+		{
+			"pass - contract sends the coins of the sender with a send authorization",
+			func() (common.Address, common.Address, common.Address) {
+				forwarder := s.DeployForwarder(s.precompile.Address())
+				limit := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdk.NewIntFromBigInt(amount)))
+				expiration := s.Ctx.BlockTime().AddDate(1, 0, 0)
+				s.Require().NoError(s.App.AuthzKeeper.SaveGrant(
+					s.Ctx, forwarder.Bytes(), s.Address.Bytes(), banktypes.NewSendAuthorization(limit, nil), &expiration,
+				))
+				return s.Address, forwarder, s.Address
+			},
+			false,
+		},
+		{
+			"fail - account sends the coins of another one",
+			func() (common.Address, common.Address, common.Address) {
+				return s.NewFundedAddress(), s.precompile.Address(), s.Address
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender, to, from := tc.malleate()
+			before := s.App.BankKeeper.GetBalance(s.Ctx, from.Bytes(), constants.BaseDenom)
+
+			input, err := s.precompile.Pack(bank.SendMethod, from, recipient, coins)
+			s.Require().NoError(err)
+			res := s.Call(sender, to, input)
+
+			after := s.App.BankKeeper.GetBalance(s.Ctx, from.Bytes(), constants.BaseDenom)
+			received := s.App.BankKeeper.GetBalance(s.Ctx, recipient.Bytes(), constants.BaseDenom)
+			if tc.expRevert {
+				s.Require().True(res.Failed())
+				s.Require().Equal(before.String(), after.String())
+				s.Require().True(received.IsZero())
+				return
+			}
+
+			s.Require().False(res.Failed(), res.VmError)
+			s.Require().Equal(before.Amount.Sub(sdk.NewIntFromBigInt(amount)).String(), after.Amount.String())
+			s.Require().Equal(amount, received.Amount.BigInt())
+
+			s.Require().Len(res.Logs, 1)
+			log := res.Logs[0]
+			s.Require().Equal(s.precompile.Address().Hex(), log.Address)
+			s.Require().Equal([]string{
+				s.precompile.Events[bank.EventTypeSend].ID.Hex(),
+				common.BytesToHash(from.Bytes()).Hex(),
+				common.BytesToHash(recipient.Bytes()).Hex(),
+			}, log.Topics)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFailedSendGas() {
+	// the balance is read from the store before the send fails, so the failing call
+	// must pay for it on top of the required gas
+	amount := testutil.DefaultBalance.AddRaw(1).BigInt()
+	feeMarketParams := s.App.FeeMarketKeeper.GetParams(s.Ctx)
+	feeMarketParams.MinGasMultiplier = sdk.ZeroDec()
+	s.Require().NoError(s.App.FeeMarketKeeper.SetParams(s.Ctx, feeMarketParams))
+
+	input, err := s.precompile.Pack(
+		bank.SendMethod, s.Address, utiltx.GenerateAddress(), []cmn.Coin{{Denom: constants.BaseDenom, Amount: amount}},
+	)
+	s.Require().NoError(err)
+
+	res := s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().True(res.Failed())
+
+	intrinsicGas, err := evmkeeper.IntrinsicGas(input, nil, false, true, true, true)
+	s.Require().NoError(err)
+	s.Require().Greater(res.GasUsed, intrinsicGas+s.precompile.RequiredGas(input))
+}
+
+func (s *PrecompileTestSuite) TestQueries() {
+	s.Run("balances", func() {
+		input, err := s.precompile.Pack(bank.BalancesMethod, s.Address)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().False(res.Failed(), res.VmError)
+
+		out, err := s.precompile.Unpack(bank.BalancesMethod, res.Ret)
+		s.Require().NoError(err)
+		balances, err := cmn.UnpackCoins(out[0])
+		s.Require().NoError(err)
+		s.Require().Equal([]cmn.Coin{{Denom: constants.BaseDenom, Amount: testutil.DefaultBalance.BigInt()}}, balances)
+	})
+
+	s.Run("supplyOf and totalSupply", func() {
+		supply := s.App.BankKeeper.GetSupply(s.Ctx, constants.BaseDenom)
+
+		input, err := s.precompile.Pack(bank.SupplyOfMethod, constants.BaseDenom)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().False(res.Failed(), res.VmError)
+		out, err := s.precompile.Unpack(bank.SupplyOfMethod, res.Ret)
+		s.Require().NoError(err)
+		s.Require().Equal(supply.Amount.BigInt(), out[0])
+
+		input, err = s.precompile.Pack(bank.TotalSupplyMethod)
+		s.Require().NoError(err)
+		res = s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().False(res.Failed(), res.VmError)
+		out, err = s.precompile.Unpack(bank.TotalSupplyMethod, res.Ret)
+		s.Require().NoError(err)
+		total, err := cmn.UnpackCoins(out[0])
+		s.Require().NoError(err)
+		s.Require().Contains(total, cmn.Coin{Denom: constants.BaseDenom, Amount: supply.Amount.BigInt()})
+	})
+}
+
+func (s *PrecompileTestSuite) TestInvalidCalls() {
+	testCases := []struct {
+		name  string
+		input []byte
+	}{
+		{"unknown method", crypto.Keccak256([]byte("mint(address,uint256)"))[:4]},
+		{"input without selector", []byte{0x01}},
+		{"invalid arguments", crypto.Keccak256([]byte("send(address,address,(string,uint256)[])"))[:4]},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := s.Call(s.Address, s.precompile.Address(), tc.input)
+			s.Require().True(res.Failed())
+		})
+	}
+
+	s.Run("precompile is active by default", func() {
+		params := s.App.EvmKeeper.GetParams(s.Ctx)
+		s.Require().Contains(params.ActivePrecompiles, evmtypes.BankPrecompileAddress.Hex())
+	})
+}
@@ -0,0 +1,50 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+)
+
+// Balances returns all the balances of an account.
+func (p Precompile) Balances(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: expected 1, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid account", cmn.ErrInvalidArguments)
+	}
+
+	balances := p.bankKeeper.GetAllBalances(ctx, cmn.AccAddress(account))
+	return method.Outputs.Pack(cmn.NewCoinsResponse(balances))
+}
+
+// SupplyOf returns the total supply of a denomination.
+func (p Precompile) SupplyOf(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: expected 1, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid denomination", cmn.ErrInvalidArguments)
+	}
+
+	supply := p.bankKeeper.GetSupply(ctx, denom)
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// TotalSupply returns the total supply of all the denominations.
+func (p Precompile) TotalSupply(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	supply := sdk.NewCoins()
+	p.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = supply.Add(coin)
+		return false
+	})
+	return method.Outputs.Pack(cmn.NewCoinsResponse(supply))
+}
@@ -0,0 +1,54 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+)
+
+// Send sends coins from an account to another one through a bank MsgSend. The caller can only
+// send its own coins, unless the sender granted it a send authorization.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("%w: expected 3, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid sender", cmn.ErrInvalidArguments)
+	}
+	to, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid recipient", cmn.ErrInvalidArguments)
+	}
+	abiCoins, err := cmn.UnpackCoins(args[2])
+	if err != nil {
+		return nil, err
+	}
+	coins, err := cmn.ToSDKCoins(abiCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := banktypes.NewMsgSend(cmn.AccAddress(from), cmn.AccAddress(to), coins)
+	if _, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvent(evm, p.Events[EventTypeSend], from, to, cmn.NewCoinsResponse(coins)); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
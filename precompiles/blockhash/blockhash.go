@@ -0,0 +1,66 @@
+package blockhash
+
+import (
+	"math/big"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// Precompile is the EIP-2935 style block hash history system contract. It returns the hash of
+// one of the last evmtypes.BlockHashHistoryServeWindow blocks from the block hash ring buffer of
+// the evm module, which keeps the whole window while the contract is active. Like EIP-2935, the
+// input is the 32 bytes big endian block number, the output is the 32 bytes block hash and the
+// calls revert without reason if the input is invalid or the block is out of the window.
+type Precompile struct {
+	readGas uint64
+}
+
+// NewPrecompile creates the block hash history precompiled contract.
+func NewPrecompile() Precompile {
+	return Precompile{readGas: storetypes.KVGasConfig().ReadCostFlat}
+}
+
+// Address returns the address of the precompiled contract.
+func (Precompile) Address() common.Address {
+	return evmtypes.BlockHashHistoryAddress
+}
+
+// RequiredGas returns the flat cost of a store read.
+func (p Precompile) RequiredGas(_ []byte) uint64 {
+	return p.readGas
+}
+
+// Run implements vm.PrecompiledContract, the block hash history can only run through
+// RunStateful.
+func (Precompile) Run(_ []byte) ([]byte, error) {
+	return nil, cmn.ErrStatefulOnly
+}
+
+// RunStateful returns the hash of the block number of the contract input.
+func (Precompile) RunStateful(evm *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) != common.HashLength {
+		return nil, vm.ErrExecutionReverted
+	}
+	if contract.Value() != nil && contract.Value().Sign() != 0 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	number := new(big.Int).SetBytes(contract.Input)
+	current := evm.Context.BlockNumber
+	if number.Cmp(current) >= 0 {
+		return nil, vm.ErrExecutionReverted
+	}
+	if new(big.Int).Sub(current, number).Cmp(big.NewInt(evmtypes.BlockHashHistoryServeWindow)) > 0 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	return evm.Context.GetHash(number.Uint64()).Bytes(), nil
+}
@@ -0,0 +1,87 @@
+package blockhash_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/precompiles/blockhash"
+	"github.com/servprotocolorg/serv/v12/precompiles/testutil"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	precompile blockhash.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.PrecompileTestSuite.SetupTest()
+	s.precompile = blockhash.NewPrecompile()
+
+	params := s.App.EvmKeeper.GetParams(s.Ctx)
+	params.ActivePrecompiles = append(params.ActivePrecompiles, s.precompile.Address().Hex())
+	s.Require().NoError(s.App.EvmKeeper.SetParams(s.Ctx, params))
+}
+
+func (s *PrecompileTestSuite) TestBlockHash() {
+	const current = evmtypes.BlockHashHistoryServeWindow + 10
+	oldest := uint64(current - evmtypes.BlockHashHistoryServeWindow)
+	oldestHash := common.HexToHash("0x01")
+	parentHash := common.HexToHash("0x02")
+
+	testCases := []struct {
+		name      string
+		input     []byte
+		expRevert bool
+		expHash   common.Hash
+	}{
+		{"parent block", common.BigToHash(big.NewInt(current - 1)).Bytes(), false, parentHash},
+		{"oldest block of the window", common.BigToHash(new(big.Int).SetUint64(oldest)).Bytes(), false, oldestHash},
+		{"block without hash", common.BigToHash(big.NewInt(current - 2)).Bytes(), false, common.Hash{}},
+		{"block out of the window", common.BigToHash(new(big.Int).SetUint64(oldest - 1)).Bytes(), true, common.Hash{}},
+		{"current block", common.BigToHash(big.NewInt(current)).Bytes(), true, common.Hash{}},
+		{"future block", common.BigToHash(big.NewInt(current + 1)).Bytes(), true, common.Hash{}},
+		{"short input", []byte{1}, true, common.Hash{}},
+		{"long input", make([]byte, 33), true, common.Hash{}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.App.EvmKeeper.SetBlockHash(s.Ctx, oldest, oldestHash)
+			s.App.EvmKeeper.SetBlockHash(s.Ctx, current-1, parentHash)
+			s.Ctx = s.Ctx.WithBlockHeight(current)
+
+			res := s.Call(s.Address, s.precompile.Address(), tc.input)
+			if tc.expRevert {
+				s.Require().True(res.Failed())
+				s.Require().Empty(res.Ret)
+				return
+			}
+			s.Require().False(res.Failed(), res.VmError)
+			s.Require().Equal(tc.expHash.Bytes(), res.Ret)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestInactive() {
+	params := s.App.EvmKeeper.GetParams(s.Ctx)
+	params.ActivePrecompiles = evmtypes.DefaultActivePrecompiles
+	s.Require().NoError(s.App.EvmKeeper.SetParams(s.Ctx, params))
+
+	s.App.EvmKeeper.SetBlockHash(s.Ctx, 1, common.HexToHash("0x01"))
+	s.Ctx = s.Ctx.WithBlockHeight(2)
+
+	// the address has no code when the contract isn't active
+	res := s.Call(s.Address, s.precompile.Address(), common.BigToHash(big.NewInt(1)).Bytes())
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Empty(res.Ret)
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AuthzKeeper defines the expected authz keeper interface, used to execute the messages of
+// the precompiled contracts on behalf of their caller.
+type AuthzKeeper interface {
+	DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error)
+}
+
+// DispatchMsg validates and executes the given message on behalf of the caller of a
+// precompiled contract, it returns the encoded message response. The caller can only act
+// for itself, unless the message signer granted it an authz authorization for the message.
+func DispatchMsg(ctx sdk.Context, authzKeeper AuthzKeeper, caller common.Address, msg sdk.Msg) ([]byte, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	results, err := authzKeeper.DispatchActions(ctx, AccAddress(caller), []sdk.Msg{msg})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
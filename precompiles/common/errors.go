@@ -0,0 +1,20 @@
+package common
+
+import "errors"
+
+var (
+	// ErrStatefulOnly is returned when a stateful precompiled contract is run without state.
+	ErrStatefulOnly = errors.New("precompiled contract can only run statefully")
+	// ErrDelegateCall is returned when a precompiled contract is called through a delegate call or a call code.
+	ErrDelegateCall = errors.New("precompiled contract cannot be called through delegate call or call code")
+	// ErrInvalidInput is returned when the input is too short to contain a method selector.
+	ErrInvalidInput = errors.New("invalid input length")
+	// ErrNotPayable is returned when value is sent along the call of a method.
+	ErrNotPayable = errors.New("method is not payable")
+	// ErrStateDB is returned when the EVM state does not support native actions.
+	ErrStateDB = errors.New("state db does not support native actions")
+	// ErrUnknownMethod is returned when the called method has no handler.
+	ErrUnknownMethod = errors.New("unknown method")
+	// ErrInvalidArguments is returned when the arguments don't match the method inputs.
+	ErrInvalidArguments = errors.New("invalid arguments")
+)
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+)
+
+// EmitEvent adds the log of the given event to the EVM state, the values are given in the
+// order of the event inputs, the indexed ones are encoded as topics.
+func (p Precompile) EmitEvent(evm *vm.EVM, event abi.Event, values ...interface{}) error {
+	if len(values) != len(event.Inputs) {
+		return ErrInvalidArguments
+	}
+
+	topics := []common.Hash{event.ID}
+	var (
+		indexed    []interface{}
+		nonIndexed []interface{}
+	)
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, values[i])
+		} else {
+			nonIndexed = append(nonIndexed, values[i])
+		}
+	}
+
+	if len(indexed) > 0 {
+		query := make([][]interface{}, len(indexed))
+		for i, value := range indexed {
+			query[i] = []interface{}{value}
+		}
+		indexedTopics, err := abi.MakeTopics(query...)
+		if err != nil {
+			return err
+		}
+		for _, topic := range indexedTopics {
+			topics = append(topics, topic[0])
+		}
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return err
+	}
+
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address:     p.address,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+	return nil
+}
@@ -0,0 +1,173 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
+)
+
+// revertSelector is the selector of the Error(string) revert reason.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// MethodHandler executes a precompile method with the unpacked arguments. The given context
+// is a branch of the EVM state, its gas meter is limited to the gas left on the contract.
+type MethodHandler func(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error)
+
+// Precompile is the base of the stateful precompiled contracts wrapping the Cosmos SDK modules.
+// The calls are ABI encoded, the methods are executed on a branch of the native state and
+// the gas consumed by the Cosmos SDK stores is charged to the contract.
+type Precompile struct {
+	abi.ABI
+
+	address     common.Address
+	kvGasConfig storetypes.GasConfig
+}
+
+// NewPrecompile creates the base of a stateful precompiled contract at the given address.
+func NewPrecompile(address common.Address, contractABI abi.ABI) Precompile {
+	return Precompile{
+		ABI:         contractABI,
+		address:     address,
+		kvGasConfig: storetypes.KVGasConfig(),
+	}
+}
+
+// LoadABI parses the JSON encoded ABI of a precompiled contract.
+func LoadABI(bz []byte) (abi.ABI, error) {
+	return abi.JSON(bytes.NewReader(bz))
+}
+
+// Address returns the address of the precompiled contract.
+func (p Precompile) Address() common.Address {
+	return p.address
+}
+
+// RequiredGas returns the minimum gas required to run the precompiled contract, it's the flat
+// cost of a store write for the transactions or of a store read for the queries, plus the cost
+// per byte of the arguments. The gas consumed by the execution is charged separately.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	argsLen := uint64(len(input) - 4)
+	if method.IsConstant() {
+		return p.kvGasConfig.ReadCostFlat + p.kvGasConfig.ReadCostPerByte*argsLen
+	}
+	return p.kvGasConfig.WriteCostFlat + p.kvGasConfig.WriteCostPerByte*argsLen
+}
+
+// Run implements vm.PrecompiledContract, the stateful precompiled contracts can only run
+// through RunStateful.
+func (p Precompile) Run(_ []byte) ([]byte, error) {
+	return nil, ErrStatefulOnly
+}
+
+// RunMethod unpacks the method called by the contract input and executes it with the given
+// handler on a branch of the native state, which is committed along with the EVM state.
+// The failures are returned as reverts with the error message as reason, except running out
+// of gas and the write protection, which consume all the gas left.
+func (p Precompile) RunMethod(evm *vm.EVM, contract *vm.Contract, readOnly bool, handler MethodHandler) ([]byte, error) {
+	bz, err := p.runMethod(evm, contract, readOnly, handler)
+	if err == nil || errors.Is(err, vm.ErrOutOfGas) || errors.Is(err, vm.ErrWriteProtection) {
+		return bz, err
+	}
+	return RevertReason(err), vm.ErrExecutionReverted
+}
+
+func (p Precompile) runMethod(evm *vm.EVM, contract *vm.Contract, readOnly bool, handler MethodHandler) ([]byte, error) {
+	// delegate calls and call codes would run the method on behalf of the calling contract
+	if contract.Address() != p.address {
+		return nil, ErrDelegateCall
+	}
+
+	if len(contract.Input) < 4 {
+		return nil, ErrInvalidInput
+	}
+	method, err := p.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.Value() != nil && contract.Value().Sign() != 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotPayable, method.Name)
+	}
+	if readOnly && !method.IsConstant() {
+		return nil, vm.ErrWriteProtection
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB, ok := evm.StateDB.(statedb.ExtStateDB)
+	if !ok {
+		return nil, ErrStateDB
+	}
+
+	var (
+		bz       []byte
+		gasMeter sdk.GasMeter
+	)
+	err = stateDB.ExecuteNativeAction(func(ctx sdk.Context) (err error) {
+		gasMeter = sdk.NewGasMeter(contract.Gas)
+		ctx = ctx.
+			WithGasMeter(gasMeter).
+			WithKVGasConfig(p.kvGasConfig).
+			WithTransientKVGasConfig(storetypes.TransientGasConfig())
+
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+					panic(r)
+				}
+				err = vm.ErrOutOfGas
+			}
+		}()
+
+		bz, err = handler(ctx, evm, contract, method, args)
+		return err
+	})
+
+	// the gas consumed by the handler is charged even if it failed
+	if gasMeter != nil && !contract.UseGas(gasMeter.GasConsumedToLimit()) {
+		return nil, vm.ErrOutOfGas
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// RevertReason returns the Error(string) ABI encoding of the given error, which is the
+// revert reason returned by the precompiled contracts.
+func RevertReason(err error) []byte {
+	typ, _ := abi.NewType("string", "", nil)
+	packed, packErr := (abi.Arguments{{Type: typ}}).Pack(err.Error())
+	if packErr != nil {
+		return nil
+	}
+	return append(common.CopyBytes(revertSelector), packed...)
+}
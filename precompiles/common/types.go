@@ -0,0 +1,54 @@
+package common
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Coin is the ABI representation of a Cosmos SDK coin.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// NewCoinsResponse converts the given Cosmos SDK coins to their ABI representation.
+func NewCoinsResponse(coins sdk.Coins) []Coin {
+	res := make([]Coin, len(coins))
+	for i, coin := range coins {
+		res[i] = Coin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return res
+}
+
+// ToSDKCoins converts the given ABI coins to sorted Cosmos SDK coins.
+func ToSDKCoins(coins []Coin) (sdk.Coins, error) {
+	res := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		if coin.Amount == nil {
+			return nil, ErrInvalidArguments
+		}
+		res = append(res, sdk.Coin{Denom: coin.Denom, Amount: sdkmath.NewIntFromBigInt(coin.Amount)})
+	}
+	return res.Sort(), nil
+}
+
+// UnpackCoins converts an unpacked Coin[] argument to ABI coins.
+func UnpackCoins(arg interface{}) (coins []Coin, err error) {
+	defer func() {
+		// abi.ConvertType panics when the argument doesn't match the struct layout
+		if r := recover(); r != nil {
+			err = ErrInvalidArguments
+		}
+	}()
+	return *abi.ConvertType(arg, new([]Coin)).(*[]Coin), nil
+}
+
+// AccAddress returns the Cosmos SDK account address of the given Ethereum address.
+func AccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
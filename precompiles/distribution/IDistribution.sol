@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IDistribution contract's address.
+address constant DISTRIBUTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
+
+/// @dev The IDistribution contract's instance.
+IDistribution constant DISTRIBUTION_CONTRACT = IDistribution(DISTRIBUTION_PRECOMPILE_ADDRESS);
+
+/// @title Distribution precompiled contract
+/// @dev The interface through which solidity contracts interact with the distribution module.
+/// The validators are given by their bech32 operator address. The caller can only act for
+/// itself, unless the delegator granted it an authorization for the distribution message.
+interface IDistribution {
+    /// @dev Emitted when the address receiving the rewards of a delegator is set.
+    /// @param delegatorAddress The address of the delegator
+    /// @param withdrawerAddress The address receiving the rewards
+    event SetWithdrawAddress(address indexed delegatorAddress, address indexed withdrawerAddress);
+
+    /// @dev Emitted when the rewards of a delegation are withdrawn.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @param amount The rewards withdrawn
+    event WithdrawDelegatorRewards(address indexed delegatorAddress, string validatorAddress, Coin[] amount);
+
+    /// @dev Sets the address receiving the rewards of a delegator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param withdrawerAddress The address receiving the rewards
+    /// @return success Whether the address was set
+    function setWithdrawAddress(
+        address delegatorAddress,
+        address withdrawerAddress
+    ) external returns (bool success);
+
+    /// @dev Withdraws the rewards of a delegation.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @return amount The rewards withdrawn
+    function withdrawDelegatorRewards(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external returns (Coin[] memory amount);
+
+    /// @dev Returns the rewards accrued by a delegation, truncated to integer amounts.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @return rewards The rewards of the delegation
+    function delegationRewards(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external view returns (Coin[] memory rewards);
+}
@@ -0,0 +1,88 @@
+package distribution
+
+import (
+	"context"
+	_ "embed" // embed the contract ABI
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+const (
+	// WithdrawDelegatorRewardsMethod defines the ABI method name of the rewards withdrawal transaction.
+	WithdrawDelegatorRewardsMethod = "withdrawDelegatorRewards"
+	// SetWithdrawAddressMethod defines the ABI method name of the withdraw address transaction.
+	SetWithdrawAddressMethod = "setWithdrawAddress"
+	// DelegationRewardsMethod defines the ABI method name of the delegation rewards query.
+	DelegationRewardsMethod = "delegationRewards"
+
+	// EventTypeWithdrawDelegatorRewards defines the event emitted by the rewards withdrawal transaction.
+	EventTypeWithdrawDelegatorRewards = "WithdrawDelegatorRewards"
+	// EventTypeSetWithdrawAddress defines the event emitted by the withdraw address transaction.
+	EventTypeSetWithdrawAddress = "SetWithdrawAddress"
+)
+
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// DistributionQuerier defines the expected distribution query server interface.
+type DistributionQuerier interface {
+	DelegationRewards(
+		c context.Context, req *distrtypes.QueryDelegationRewardsRequest,
+	) (*distrtypes.QueryDelegationRewardsResponse, error)
+}
+
+// Precompile is the stateful precompiled contract wrapping the distribution module.
+type Precompile struct {
+	cmn.Precompile
+
+	distrQuerier DistributionQuerier
+	authzKeeper  cmn.AuthzKeeper
+}
+
+// NewPrecompile creates the distribution precompiled contract.
+func NewPrecompile(distrQuerier DistributionQuerier, authzKeeper cmn.AuthzKeeper) (Precompile, error) {
+	contractABI, err := cmn.LoadABI(abiJSON)
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:   cmn.NewPrecompile(evmtypes.DistributionPrecompileAddress, contractABI),
+		distrQuerier: distrQuerier,
+		authzKeeper:  authzKeeper,
+	}, nil
+}
+
+// RunStateful executes the distribution method called by the contract input.
+func (p Precompile) RunStateful(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunMethod(evm, contract, readOnly, p.execute)
+}
+
+func (p Precompile) execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	case WithdrawDelegatorRewardsMethod:
+		return p.WithdrawDelegatorRewards(ctx, evm, contract, method, args)
+	case SetWithdrawAddressMethod:
+		return p.SetWithdrawAddress(ctx, evm, contract, method, args)
+	case DelegationRewardsMethod:
+		return p.DelegationRewards(ctx, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+}
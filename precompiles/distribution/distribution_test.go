@@ -0,0 +1,153 @@
+package distribution_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/constants"
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/precompiles/distribution"
+	"github.com/servprotocolorg/serv/v12/precompiles/testutil"
+	servtestutil "github.com/servprotocolorg/serv/v12/testutil"
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+)
+
+var rewards = sdk.NewInt(1_000_000)
+
+type PrecompileTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	precompile distribution.Precompile
+	validator  sdk.ValAddress
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.PrecompileTestSuite.SetupTest()
+
+	precompile, err := distribution.NewPrecompile(distrkeeper.NewQuerier(s.App.DistrKeeper), s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+
+	// delegate to a new validator accruing rewards
+	s.Ctx, err = servtestutil.PrepareAccountsForDelegationRewards(s.T(), s.Ctx, s.App, s.Address.Bytes(), sdk.ZeroInt(), rewards)
+	s.Require().NoError(err)
+	// rewards are only accrued after the height of the delegation
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	delegations := s.App.StakingKeeper.GetDelegatorDelegations(s.Ctx, s.Address.Bytes(), 10)
+	s.Require().Len(delegations, 1)
+	s.validator = delegations[0].GetValidatorAddr()
+}
+
+func (s *PrecompileTestSuite) TestDelegationRewards() {
+	input, err := s.precompile.Pack(distribution.DelegationRewardsMethod, s.Address, s.validator.String())
+	s.Require().NoError(err)
+	res := s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().False(res.Failed(), res.VmError)
+
+	out, err := s.precompile.Unpack(distribution.DelegationRewardsMethod, res.Ret)
+	s.Require().NoError(err)
+	coins, err := cmn.UnpackCoins(out[0])
+	s.Require().NoError(err)
+	s.Require().Equal([]cmn.Coin{{Denom: constants.BaseDenom, Amount: rewards.BigInt()}}, coins)
+}
+
+func (s *PrecompileTestSuite) TestWithdrawDelegatorRewards() {
+	testCases := []struct {
+		name      string
+		malleate  func() (to common.Address)
+		expRevert bool
+	}{
+		{
+			"pass - delegator withdraws its own rewards",
+			func() common.Address {
+				return s.precompile.Address()
+			},
+			false,
+		},
+		{
+			"fail - contract withdraws the rewards of the delegator without authorization",
+			func() common.Address {
+				return s.DeployForwarder(s.precompile.Address())
+			},
+			true,
+		},
+		{
+			"pass - contract withdraws the rewards of the delegator with an authorization",
+			func() common.Address {
+				forwarder := s.DeployForwarder(s.precompile.Address())
+				expiration := s.Ctx.BlockTime().AddDate(1, 0, 0)
+				authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}))
+				s.Require().NoError(s.App.AuthzKeeper.SaveGrant(s.Ctx, forwarder.Bytes(), s.Address.Bytes(), authorization, &expiration))
+				return forwarder
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			to := tc.malleate()
+			before := s.App.BankKeeper.GetBalance(s.Ctx, s.Address.Bytes(), constants.BaseDenom)
+
+			input, err := s.precompile.Pack(distribution.WithdrawDelegatorRewardsMethod, s.Address, s.validator.String())
+			s.Require().NoError(err)
+			res := s.Call(s.Address, to, input)
+
+			after := s.App.BankKeeper.GetBalance(s.Ctx, s.Address.Bytes(), constants.BaseDenom)
+			if tc.expRevert {
+				s.Require().True(res.Failed())
+				s.Require().Equal(before.String(), after.String())
+				return
+			}
+
+			s.Require().False(res.Failed(), res.VmError)
+			s.Require().Equal(before.Amount.Add(rewards).String(), after.Amount.String())
+
+			out, err := s.precompile.Unpack(distribution.WithdrawDelegatorRewardsMethod, res.Ret)
+			s.Require().NoError(err)
+			coins, err := cmn.UnpackCoins(out[0])
+			s.Require().NoError(err)
+			s.Require().Equal([]cmn.Coin{{Denom: constants.BaseDenom, Amount: rewards.BigInt()}}, coins)
+
+			s.Require().Len(res.Logs, 1)
+			s.Require().Equal(s.precompile.Events[distribution.EventTypeWithdrawDelegatorRewards].ID.Hex(), res.Logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSetWithdrawAddress() {
+	withdrawer := utiltx.GenerateAddress()
+
+	input, err := s.precompile.Pack(distribution.SetWithdrawAddressMethod, s.Address, withdrawer)
+	s.Require().NoError(err)
+	res := s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Equal(sdk.AccAddress(withdrawer.Bytes()), s.App.DistrKeeper.GetDelegatorWithdrawAddr(s.Ctx, s.Address.Bytes()))
+
+	s.Require().Len(res.Logs, 1)
+	s.Require().Equal([]string{
+		s.precompile.Events[distribution.EventTypeSetWithdrawAddress].ID.Hex(),
+		common.BytesToHash(s.Address.Bytes()).Hex(),
+		common.BytesToHash(withdrawer.Bytes()).Hex(),
+	}, res.Logs[0].Topics)
+
+	// the rewards are now sent to the withdraw address
+	input, err = s.precompile.Pack(distribution.WithdrawDelegatorRewardsMethod, s.Address, s.validator.String())
+	s.Require().NoError(err)
+	res = s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().False(res.Failed(), res.VmError)
+	balance := s.App.BankKeeper.GetBalance(s.Ctx, withdrawer.Bytes(), constants.BaseDenom)
+	s.Require().Equal(rewards.BigInt(), balance.Amount.BigInt())
+}
@@ -0,0 +1,34 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+)
+
+// DelegationRewards returns the rewards accrued by a delegation, truncated to integer amounts.
+func (p Precompile) DelegationRewards(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected 2, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.distrQuerier.DelegationRewards(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: cmn.AccAddress(delegator).String(),
+		ValidatorAddress: validator.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, _ := res.Rewards.TruncateDecimal()
+	return method.Outputs.Pack(cmn.NewCoinsResponse(rewards))
+}
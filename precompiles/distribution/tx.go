@@ -0,0 +1,102 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+)
+
+// WithdrawDelegatorRewards withdraws the rewards of a delegation through a distribution
+// MsgWithdrawDelegatorReward and returns the withdrawn coins. The caller can only withdraw its
+// own rewards, unless the delegator granted it an authorization for the message.
+func (p Precompile) WithdrawDelegatorRewards(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected 2, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := distrtypes.NewMsgWithdrawDelegatorReward(cmn.AccAddress(delegator), validator)
+	bz, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg)
+	if err != nil {
+		return nil, err
+	}
+	var res distrtypes.MsgWithdrawDelegatorRewardResponse
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	amount := cmn.NewCoinsResponse(res.Amount)
+
+	if err := p.EmitEvent(
+		evm, p.Events[EventTypeWithdrawDelegatorRewards], delegator, msg.ValidatorAddress, amount,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(amount)
+}
+
+// SetWithdrawAddress sets the address receiving the rewards of a delegator through a
+// distribution MsgSetWithdrawAddress. The caller can only set its own withdraw address, unless
+// the delegator granted it an authorization for the message.
+func (p Precompile) SetWithdrawAddress(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected 2, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid delegator address", cmn.ErrInvalidArguments)
+	}
+	withdrawer, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid withdrawer address", cmn.ErrInvalidArguments)
+	}
+
+	msg := distrtypes.NewMsgSetWithdrawAddress(cmn.AccAddress(delegator), cmn.AccAddress(withdrawer))
+	if _, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvent(evm, p.Events[EventTypeSetWithdrawAddress], delegator, withdrawer); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// parseDelegationArgs returns the delegator and validator addresses, which are the first
+// arguments of the delegation methods.
+func parseDelegationArgs(args []interface{}) (common.Address, sdk.ValAddress, error) {
+	delegator, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("%w: invalid delegator address", cmn.ErrInvalidArguments)
+	}
+	bech32, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("%w: invalid validator address", cmn.ErrInvalidArguments)
+	}
+	validator, err := sdk.ValAddressFromBech32(bech32)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return delegator, validator, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IICS20 contract's address.
+address constant ICS20_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000802;
+
+/// @dev The IICS20 contract's instance.
+IICS20 constant ICS20_CONTRACT = IICS20(ICS20_PRECOMPILE_ADDRESS);
+
+/// @dev ICS20Allocation is an allocation of an ICS-20 transfer authorization.
+/// @param sourcePort The port of the transfers
+/// @param sourceChannel The channel of the transfers
+/// @param spendLimit The coins that can be transferred, ERC20 contracts of registered token pairs
+/// are given as their hex address
+/// @param allowList The allowed receivers, any receiver is allowed if it's empty
+struct ICS20Allocation {
+    string sourcePort;
+    string sourceChannel;
+    Coin[] spendLimit;
+    string[] allowList;
+}
+
+/// @title ICS-20 transfer precompiled contract
+/// @dev The interface through which solidity contracts send tokens to other chains through IBC.
+interface IICS20 {
+    /// @dev Emitted when tokens are sent to another chain.
+    /// @param sender The address of the sender
+    /// @param receiver The address of the receiver on the counterparty chain
+    /// @param sourceChannel The channel of the transfer
+    /// @param denom The denomination of the coins sent
+    /// @param amount The amount sent
+    /// @param sequence The sequence of the packet
+    event IBCTransfer(
+        address indexed sender,
+        string receiver,
+        string sourceChannel,
+        string denom,
+        uint256 amount,
+        uint64 sequence
+    );
+
+    /// @dev Emitted when a transfer authorization is granted.
+    /// @param grantee The address allowed to transfer the tokens
+    /// @param granter The address owning the tokens
+    /// @param allocations The allocations of the authorization
+    event Approval(address indexed grantee, address indexed granter, ICS20Allocation[] allocations);
+
+    /// @dev Emitted when a transfer authorization is revoked.
+    /// @param grantee The address that was allowed to transfer the tokens
+    /// @param granter The address owning the tokens
+    event Revocation(address indexed grantee, address indexed granter);
+
+    /// @dev Sends tokens of the caller to another chain. ERC20 tokens of registered token pairs
+    /// are converted to their coin representation first.
+    /// @param sourceChannel The channel of the transfer on the transfer port
+    /// @param denom The denomination, or the hex address of the ERC20 contract, of the tokens
+    /// @param amount The amount to send
+    /// @param receiver The address of the receiver on the counterparty chain
+    /// @param timeoutTimestamp The timeout of the packet in nanoseconds, zero for the default timeout
+    /// @return sequence The sequence of the packet
+    function transfer(
+        string calldata sourceChannel,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 sequence);
+
+    /// @dev Sends tokens of the sender to another chain. The caller can only send its own tokens,
+    /// unless the sender granted it a transfer authorization.
+    /// @param sender The address of the sender
+    /// @param sourceChannel The channel of the transfer on the transfer port
+    /// @param denom The denomination, or the hex address of the ERC20 contract, of the tokens
+    /// @param amount The amount to send
+    /// @param receiver The address of the receiver on the counterparty chain
+    /// @param timeoutTimestamp The timeout of the packet in nanoseconds, zero for the default timeout
+    /// @return sequence The sequence of the packet
+    function transferFrom(
+        address sender,
+        string calldata sourceChannel,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 sequence);
+
+    /// @dev Grants a transfer authorization on the tokens of the caller, replacing the existing one.
+    /// @param grantee The address allowed to transfer the tokens
+    /// @param allocations The allocations of the authorization
+    /// @return approved Whether the authorization was granted
+    function approve(address grantee, ICS20Allocation[] calldata allocations) external returns (bool approved);
+
+    /// @dev Revokes the transfer authorization granted by the caller.
+    /// @param grantee The address allowed to transfer the tokens
+    /// @return revoked Whether the authorization was revoked
+    function revoke(address grantee) external returns (bool revoked);
+
+    /// @dev Returns the allocations of the transfer authorization granted by the granter to the grantee.
+    /// @param grantee The address allowed to transfer the tokens
+    /// @param granter The address owning the tokens
+    /// @return allocations The remaining allocations, empty if there is no authorization
+    function allowance(
+        address grantee,
+        address granter
+    ) external view returns (ICS20Allocation[] memory allocations);
+}
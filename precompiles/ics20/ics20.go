@@ -0,0 +1,123 @@
+package ics20
+
+import (
+	_ "embed" // embed the contract ABI
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	erc20types "github.com/servprotocolorg/serv/v12/x/erc20/types"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+const (
+	// TransferMethod defines the ABI method name of the ICS-20 transfer of the caller tokens.
+	TransferMethod = "transfer"
+	// TransferFromMethod defines the ABI method name of the ICS-20 transfer on behalf of a sender.
+	TransferFromMethod = "transferFrom"
+	// ApproveMethod defines the ABI method name of the transfer authorization grant.
+	ApproveMethod = "approve"
+	// RevokeMethod defines the ABI method name of the transfer authorization revocation.
+	RevokeMethod = "revoke"
+	// AllowanceMethod defines the ABI method name of the transfer authorization query.
+	AllowanceMethod = "allowance"
+
+	// EventTypeIBCTransfer defines the event emitted by the transfer transactions.
+	EventTypeIBCTransfer = "IBCTransfer"
+	// EventTypeApproval defines the event emitted by the approve transaction.
+	EventTypeApproval = "Approval"
+	// EventTypeRevocation defines the event emitted by the revoke transaction.
+	EventTypeRevocation = "Revocation"
+
+	// DefaultExpiration is the duration of the transfer authorizations granted through the precompile.
+	DefaultExpiration = 365 * 24 * time.Hour
+)
+
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// AuthzKeeper defines the expected authz keeper interface, used to dispatch the transfers and
+// to manage the transfer authorizations.
+type AuthzKeeper interface {
+	cmn.AuthzKeeper
+	SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error
+	GetAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+}
+
+// ERC20Keeper defines the expected erc20 keeper interface, used to resolve the Cosmos coin
+// denomination of the registered ERC20 contracts.
+type ERC20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+}
+
+// Precompile is the stateful precompiled contract wrapping the ICS-20 transfers. The transfers
+// are executed by the transfer module message server, which converts the ERC20 tokens of the
+// registered token pairs when the sender lacks the Cosmos coins.
+type Precompile struct {
+	cmn.Precompile
+
+	authzKeeper AuthzKeeper
+	erc20Keeper ERC20Keeper
+}
+
+// NewPrecompile creates the ICS-20 precompiled contract.
+func NewPrecompile(authzKeeper AuthzKeeper, erc20Keeper ERC20Keeper) (Precompile, error) {
+	contractABI, err := cmn.LoadABI(abiJSON)
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:  cmn.NewPrecompile(evmtypes.ICS20PrecompileAddress, contractABI),
+		authzKeeper: authzKeeper,
+		erc20Keeper: erc20Keeper,
+	}, nil
+}
+
+// RunStateful executes the ICS-20 method called by the contract input.
+func (p Precompile) RunStateful(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunMethod(evm, contract, readOnly, p.execute)
+}
+
+func (p Precompile) execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	case TransferMethod:
+		return p.Transfer(ctx, evm, contract, method, args)
+	case TransferFromMethod:
+		return p.TransferFrom(ctx, evm, contract, method, args)
+	case ApproveMethod:
+		return p.Approve(ctx, evm, contract, method, args)
+	case RevokeMethod:
+		return p.Revoke(ctx, evm, contract, method, args)
+	case AllowanceMethod:
+		return p.Allowance(ctx, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// coinDenom returns the Cosmos coin denomination of the given denomination, which can be the
+// hex address of the ERC20 contract of a registered token pair.
+func (p Precompile) coinDenom(ctx sdk.Context, denom string) string {
+	pair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found {
+		return denom
+	}
+	return pair.Denom
+}
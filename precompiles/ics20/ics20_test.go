@@ -0,0 +1,260 @@
+package ics20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/app"
+	"github.com/servprotocolorg/serv/v12/constants"
+	ibctesting "github.com/servprotocolorg/serv/v12/ibc/testing"
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/precompiles/ics20"
+	"github.com/servprotocolorg/serv/v12/precompiles/testutil"
+	servtestutil "github.com/servprotocolorg/serv/v12/testutil"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	coordinator *ibcgotesting.Coordinator
+	path        *ibctesting.Path
+	precompile  ics20.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+// SetupTest opens a transfer channel between a Serv chain and a Cosmos chain, the calls are
+// executed on the Serv chain.
+func (s *PrecompileTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1, 1)
+	servChain := s.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	cosmosChain := s.coordinator.GetChain(ibcgotesting.GetChainID(2))
+
+	// fund the relayer accounts for the fees of the handshake transactions
+	fees := sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, testutil.DefaultBalance))
+	s.Require().NoError(servtestutil.FundAccount(
+		servChain.GetContext(), servChain.App.(*app.Serv).BankKeeper, servChain.SenderAccount.GetAddress(), fees,
+	))
+	fees = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, testutil.DefaultBalance))
+	simApp := cosmosChain.GetSimApp()
+	s.Require().NoError(simApp.BankKeeper.MintCoins(cosmosChain.GetContext(), minttypes.ModuleName, fees))
+	s.Require().NoError(simApp.BankKeeper.SendCoinsFromModuleToAccount(
+		cosmosChain.GetContext(), minttypes.ModuleName, cosmosChain.SenderAccount.GetAddress(), fees,
+	))
+
+	s.path = ibctesting.NewTransferPath(servChain, cosmosChain)
+	ibctesting.SetupPath(s.coordinator, s.path)
+
+	s.App = servChain.App.(*app.Serv)
+	s.Ctx = servChain.GetContext()
+
+	validators := s.App.StakingKeeper.GetAllValidators(s.Ctx)
+	s.Require().NotEmpty(validators)
+	s.Validator = validators[0]
+	consAddr, err := s.Validator.GetConsAddr()
+	s.Require().NoError(err)
+	header := s.Ctx.BlockHeader()
+	header.ProposerAddress = consAddr
+	s.Ctx = s.Ctx.WithBlockHeader(header)
+	s.Require().NoError(s.App.StakingKeeper.SetValidatorByConsAddr(s.Ctx, s.Validator))
+
+	s.Address = s.NewFundedAddress()
+
+	precompile, err := ics20.NewPrecompile(s.App.AuthzKeeper, s.App.Erc20Keeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+}
+
+func (s *PrecompileTestSuite) allocations(amount *big.Int) []ics20.Allocation {
+	return []ics20.Allocation{{
+		SourcePort:    s.path.EndpointA.ChannelConfig.PortID,
+		SourceChannel: s.path.EndpointA.ChannelID,
+		SpendLimit:    []cmn.Coin{{Denom: constants.BaseDenom, Amount: amount}},
+		AllowList:     []string{},
+	}}
+}
+
+func (s *PrecompileTestSuite) TestTransfer() {
+	var (
+		amount   = big.NewInt(100)
+		receiver = "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func() (sender, to, from common.Address, input []byte)
+		expRevert bool
+	}{
+		{
+			"pass - sender transfers its own coins",
+			func() (common.Address, common.Address, common.Address, []byte) {
+				input, err := s.precompile.Pack(
+					ics20.TransferMethod, s.path.EndpointA.ChannelID, constants.BaseDenom, amount, receiver, uint64(0),
+				)
+				s.Require().NoError(err)
+				return s.Address, s.precompile.Address(), s.Address, input
+			},
+			false,
+		},
+		{
+			"fail - unknown channel",
+			func() (common.Address, common.Address, common.Address, []byte) {
+				input, err := s.precompile.Pack(
+					ics20.TransferMethod, "channel-99", constants.BaseDenom, amount, receiver, uint64(0),
+				)
+				s.Require().NoError(err)
+				return s.Address, s.precompile.Address(), s.Address, input
+			},
+			true,
+		},
+		{
+			"fail - contract transfers the coins of the sender without authorization",
+			func() (common.Address, common.Address, common.Address, []byte) {
+				input, err := s.precompile.Pack(
+					ics20.TransferFromMethod, s.Address, s.path.EndpointA.ChannelID, constants.BaseDenom, amount, receiver, uint64(0),
+				)
+				s.Require().NoError(err)
+				return s.Address, s.DeployForwarder(s.precompile.Address()), s.Address, input
+			},
+			true,
+		},
+		{
+			"pass - contract transfers the coins of the sender with an approval",
+			func() (common.Address, common.Address, common.Address, []byte) {
+				forwarder := s.DeployForwarder(s.precompile.Address())
+				input, err := s.precompile.Pack(ics20.ApproveMethod, forwarder, s.allocations(amount))
+				s.Require().NoError(err)
+				res := s.Call(s.Address, s.precompile.Address(), input)
+				s.Require().False(res.Failed(), res.VmError)
+
+				input, err = s.precompile.Pack(
+					ics20.TransferFromMethod, s.Address, s.path.EndpointA.ChannelID, constants.BaseDenom, amount, receiver, uint64(0),
+				)
+				s.Require().NoError(err)
+				return s.Address, forwarder, s.Address, input
+			},
+			false,
+		},
+		{
+			"fail - contract transfers more than the approved amount",
+			func() (common.Address, common.Address, common.Address, []byte) {
+				forwarder := s.DeployForwarder(s.precompile.Address())
+				input, err := s.precompile.Pack(ics20.ApproveMethod, forwarder, s.allocations(big.NewInt(1)))
+				s.Require().NoError(err)
+				res := s.Call(s.Address, s.precompile.Address(), input)
+				s.Require().False(res.Failed(), res.VmError)
+
+				input, err = s.precompile.Pack(
+					ics20.TransferFromMethod, s.Address, s.path.EndpointA.ChannelID, constants.BaseDenom, amount, receiver, uint64(0),
+				)
+				s.Require().NoError(err)
+				return s.Address, forwarder, s.Address, input
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender, to, from, input := tc.malleate()
+			escrow := transfertypes.GetEscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+			before := s.App.BankKeeper.GetBalance(s.Ctx, from.Bytes(), constants.BaseDenom)
+
+			res := s.Call(sender, to, input)
+
+			after := s.App.BankKeeper.GetBalance(s.Ctx, from.Bytes(), constants.BaseDenom)
+			escrowed := s.App.BankKeeper.GetBalance(s.Ctx, escrow, constants.BaseDenom)
+			if tc.expRevert {
+				s.Require().True(res.Failed())
+				s.Require().Equal(before.String(), after.String())
+				s.Require().True(escrowed.IsZero())
+				return
+			}
+
+			s.Require().False(res.Failed(), res.VmError)
+			s.Require().Equal(before.Amount.Sub(sdk.NewIntFromBigInt(amount)).String(), after.Amount.String())
+			s.Require().Equal(amount, escrowed.Amount.BigInt())
+
+			out, err := s.precompile.Unpack(ics20.TransferMethod, res.Ret)
+			s.Require().NoError(err)
+			sequence := out[0].(uint64)
+			s.Require().Equal(uint64(1), sequence)
+			s.Require().True(s.App.IBCKeeper.ChannelKeeper.HasPacketCommitment(
+				s.Ctx, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sequence,
+			))
+
+			s.Require().Len(res.Logs, 1)
+			log := res.Logs[0]
+			s.Require().Equal(s.precompile.Address().Hex(), log.Address)
+			s.Require().Equal([]string{
+				s.precompile.Events[ics20.EventTypeIBCTransfer].ID.Hex(),
+				common.BytesToHash(from.Bytes()).Hex(),
+			}, log.Topics)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestApproveAndRevoke() {
+	grantee := s.DeployForwarder(s.precompile.Address())
+	allowance := func() []ics20.Allocation {
+		input, err := s.precompile.Pack(ics20.AllowanceMethod, grantee, s.Address)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().False(res.Failed(), res.VmError)
+		out, err := s.precompile.Unpack(ics20.AllowanceMethod, res.Ret)
+		s.Require().NoError(err)
+		allocations, err := ics20.UnpackAllocations(out[0])
+		s.Require().NoError(err)
+		return allocations
+	}
+
+	s.Require().Empty(allowance())
+
+	allocations := s.allocations(big.NewInt(100))
+	input, err := s.precompile.Pack(ics20.ApproveMethod, grantee, allocations)
+	s.Require().NoError(err)
+	res := s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Len(res.Logs, 1)
+	s.Require().Equal(s.precompile.Events[ics20.EventTypeApproval].ID.Hex(), res.Logs[0].Topics[0])
+	s.Require().Equal(allocations, allowance())
+
+	input, err = s.precompile.Pack(ics20.RevokeMethod, grantee)
+	s.Require().NoError(err)
+	res = s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Len(res.Logs, 1)
+	s.Require().Equal(s.precompile.Events[ics20.EventTypeRevocation].ID.Hex(), res.Logs[0].Topics[0])
+	s.Require().Empty(allowance())
+
+	s.Run("fail - revoke a missing authorization", func() {
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().True(res.Failed())
+	})
+
+	s.Run("fail - approve an invalid allocation", func() {
+		invalid := s.allocations(big.NewInt(100))
+		invalid[0].SourceChannel = ""
+		input, err := s.precompile.Pack(ics20.ApproveMethod, grantee, invalid)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().True(res.Failed())
+	})
+
+	s.Run("precompile is active by default", func() {
+		params := s.App.EvmKeeper.GetParams(s.Ctx)
+		s.Require().Contains(params.ActivePrecompiles, evmtypes.ICS20PrecompileAddress.Hex())
+	})
+}
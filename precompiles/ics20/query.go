@@ -0,0 +1,37 @@
+package ics20
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+)
+
+// Allowance returns the allocations of the ICS-20 transfer authorization granted by the granter
+// to the grantee, which are empty if there is no authorization or if it's expired.
+func (p Precompile) Allowance(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected 2, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	grantee, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid grantee", cmn.ErrInvalidArguments)
+	}
+	granter, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid granter", cmn.ErrInvalidArguments)
+	}
+
+	msgType := sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+	authorization, _ := p.authzKeeper.GetAuthorization(ctx, cmn.AccAddress(grantee), cmn.AccAddress(granter), msgType)
+	transferAuthorization, ok := authorization.(*transfertypes.TransferAuthorization)
+	if !ok {
+		return method.Outputs.Pack([]Allocation{})
+	}
+	return method.Outputs.Pack(NewAllocationsResponse(transferAuthorization.Allocations))
+}
@@ -0,0 +1,186 @@
+package ics20
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+)
+
+// Transfer sends tokens of the caller to another chain through an ICS-20 MsgTransfer on the
+// transfer port, it returns the sequence of the packet.
+func (p Precompile) Transfer(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("%w: expected 5, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	return p.transfer(ctx, evm, contract, method, contract.Caller(), args)
+}
+
+// TransferFrom sends tokens of the given sender to another chain through an ICS-20 MsgTransfer
+// on the transfer port, it returns the sequence of the packet. The caller can only send its own
+// tokens, unless the sender granted it a transfer authorization, see Approve.
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 6 {
+		return nil, fmt.Errorf("%w: expected 6, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	sender, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid sender", cmn.ErrInvalidArguments)
+	}
+	return p.transfer(ctx, evm, contract, method, sender, args[1:])
+}
+
+// transfer dispatches the MsgTransfer of the given sender with the sourceChannel, denom, amount,
+// receiver and timeoutTimestamp arguments. The ERC20 contracts of the registered token pairs are
+// transferred as their coin denomination. A zero timeout timestamp defaults to the ICS-20 default
+// relative timeout.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	sender common.Address,
+	args []interface{},
+) ([]byte, error) {
+	sourceChannel, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid source channel", cmn.ErrInvalidArguments)
+	}
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid denomination", cmn.ErrInvalidArguments)
+	}
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf("%w: invalid amount", cmn.ErrInvalidArguments)
+	}
+	receiver, ok := args[3].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid receiver", cmn.ErrInvalidArguments)
+	}
+	timeoutTimestamp, ok := args[4].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid timeout timestamp", cmn.ErrInvalidArguments)
+	}
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + transfertypes.DefaultRelativePacketTimeoutTimestamp
+	}
+
+	token := sdk.Coin{Denom: p.coinDenom(ctx, denom), Amount: sdkmath.NewIntFromBigInt(amount)}
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID, sourceChannel, token, cmn.AccAddress(sender).String(), receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, "",
+	)
+	bz, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg)
+	if err != nil {
+		return nil, err
+	}
+	var res transfertypes.MsgTransferResponse
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvent(
+		evm, p.Events[EventTypeIBCTransfer], sender, receiver, sourceChannel, token.Denom, amount, res.Sequence,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// Approve grants the given grantee an ICS-20 transfer authorization on the tokens of the caller,
+// replacing the existing one. The authorization expires after DefaultExpiration.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected 2, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	grantee, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid grantee", cmn.ErrInvalidArguments)
+	}
+	allocations, err := UnpackAllocations(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granter := contract.Caller()
+	if grantee == granter {
+		return nil, fmt.Errorf("%w: grantee cannot be the granter", cmn.ErrInvalidArguments)
+	}
+
+	transferAllocations, err := p.toTransferAllocations(ctx, allocations)
+	if err != nil {
+		return nil, err
+	}
+	authorization := transfertypes.NewTransferAuthorization(transferAllocations...)
+	if err := authorization.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	expiration := ctx.BlockTime().Add(DefaultExpiration)
+	if err := p.authzKeeper.SaveGrant(ctx, cmn.AccAddress(grantee), cmn.AccAddress(granter), authorization, &expiration); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvent(
+		evm, p.Events[EventTypeApproval], grantee, granter, NewAllocationsResponse(transferAllocations),
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// Revoke deletes the ICS-20 transfer authorization granted by the caller to the given grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: expected 1, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	grantee, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid grantee", cmn.ErrInvalidArguments)
+	}
+
+	granter := contract.Caller()
+	msgType := sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+	if err := p.authzKeeper.DeleteGrant(ctx, cmn.AccAddress(grantee), cmn.AccAddress(granter), msgType); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvent(evm, p.Events[EventTypeRevocation], grantee, granter); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,71 @@
+package ics20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+)
+
+// Allocation is the ABI representation of an allocation of an ICS-20 transfer authorization.
+type Allocation struct {
+	SourcePort    string
+	SourceChannel string
+	SpendLimit    []cmn.Coin
+	AllowList     []string
+}
+
+// UnpackAllocations converts an unpacked Allocation[] argument to ABI allocations.
+func UnpackAllocations(arg interface{}) (allocations []Allocation, err error) {
+	defer func() {
+		// abi.ConvertType panics when the argument doesn't match the struct layout
+		if r := recover(); r != nil {
+			err = cmn.ErrInvalidArguments
+		}
+	}()
+	return *abi.ConvertType(arg, new([]Allocation)).(*[]Allocation), nil
+}
+
+// NewAllocationsResponse converts the allocations of a transfer authorization to their ABI representation.
+func NewAllocationsResponse(allocations []transfertypes.Allocation) []Allocation {
+	res := make([]Allocation, len(allocations))
+	for i, allocation := range allocations {
+		allowList := allocation.AllowList
+		if allowList == nil {
+			allowList = []string{}
+		}
+		res[i] = Allocation{
+			SourcePort:    allocation.SourcePort,
+			SourceChannel: allocation.SourceChannel,
+			SpendLimit:    cmn.NewCoinsResponse(allocation.SpendLimit),
+			AllowList:     allowList,
+		}
+	}
+	return res
+}
+
+// toTransferAllocations converts the given ABI allocations to the allocations of a transfer
+// authorization, the spend limits given for ERC20 contracts are converted to their coin denomination.
+func (p Precompile) toTransferAllocations(ctx sdk.Context, allocations []Allocation) ([]transfertypes.Allocation, error) {
+	res := make([]transfertypes.Allocation, len(allocations))
+	for i, allocation := range allocations {
+		spendLimit := make([]cmn.Coin, len(allocation.SpendLimit))
+		for j, coin := range allocation.SpendLimit {
+			spendLimit[j] = cmn.Coin{Denom: p.coinDenom(ctx, coin.Denom), Amount: coin.Amount}
+		}
+		coins, err := cmn.ToSDKCoins(spendLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = transfertypes.Allocation{
+			SourcePort:    allocation.SourcePort,
+			SourceChannel: allocation.SourceChannel,
+			SpendLimit:    coins,
+			AllowList:     allocation.AllowList,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IStaking contract's address.
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000800;
+
+/// @dev The IStaking contract's instance.
+IStaking constant STAKING_CONTRACT = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+/// @title Staking precompiled contract
+/// @dev The interface through which solidity contracts interact with the staking module.
+/// The amounts are expressed in the bond denomination and the validators are given by their
+/// bech32 operator address. The caller can only act for itself, unless the delegator granted
+/// it a staking authorization.
+interface IStaking {
+    /// @dev Emitted when tokens are delegated to a validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @param amount The amount of tokens delegated
+    event Delegate(address indexed delegatorAddress, string validatorAddress, uint256 amount);
+
+    /// @dev Emitted when tokens are undelegated from a validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @param amount The amount of tokens undelegated
+    /// @param completionTime The unix timestamp at which the unbonding completes
+    event Unbond(address indexed delegatorAddress, string validatorAddress, uint256 amount, int64 completionTime);
+
+    /// @dev Emitted when tokens are redelegated from a validator to another one.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorSrcAddress The operator address of the source validator
+    /// @param validatorDstAddress The operator address of the destination validator
+    /// @param amount The amount of tokens redelegated
+    /// @param completionTime The unix timestamp at which the redelegation completes
+    event Redelegate(
+        address indexed delegatorAddress,
+        string validatorSrcAddress,
+        string validatorDstAddress,
+        uint256 amount,
+        int64 completionTime
+    );
+
+    /// @dev Delegates tokens to a validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @param amount The amount of tokens to delegate
+    /// @return success Whether the tokens were delegated
+    function delegate(
+        address delegatorAddress,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Undelegates tokens from a validator.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @param amount The amount of tokens to undelegate
+    /// @return completionTime The unix timestamp at which the unbonding completes
+    function undelegate(
+        address delegatorAddress,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Redelegates tokens from a validator to another one.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorSrcAddress The operator address of the source validator
+    /// @param validatorDstAddress The operator address of the destination validator
+    /// @param amount The amount of tokens to redelegate
+    /// @return completionTime The unix timestamp at which the redelegation completes
+    function redelegate(
+        address delegatorAddress,
+        string memory validatorSrcAddress,
+        string memory validatorDstAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Returns a delegation.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The operator address of the validator
+    /// @return shares The shares of the delegation, with 18 decimals
+    /// @return balance The tokens of the delegation
+    function delegation(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external view returns (uint256 shares, Coin memory balance);
+}
@@ -0,0 +1,37 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+)
+
+// Delegation returns the shares of a delegation, with 18 decimals, and the balance of the
+// delegation in the bond denomination. Both are zero when the delegation doesn't exist.
+func (p Precompile) Delegation(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected 2, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, validatorAddr, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := cmn.Coin{Denom: p.stakingKeeper.BondDenom(ctx), Amount: big.NewInt(0)}
+	validator, found := p.stakingKeeper.GetValidator(ctx, validatorAddr)
+	if !found {
+		return nil, fmt.Errorf("validator %s not found", validatorAddr)
+	}
+	delegation, found := p.stakingKeeper.GetDelegation(ctx, cmn.AccAddress(delegator), validatorAddr)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), balance)
+	}
+
+	balance.Amount = validator.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance)
+}
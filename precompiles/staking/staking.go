@@ -0,0 +1,94 @@
+package staking
+
+import (
+	_ "embed" // embed the contract ABI
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+const (
+	// DelegateMethod defines the ABI method name of the staking delegate transaction.
+	DelegateMethod = "delegate"
+	// UndelegateMethod defines the ABI method name of the staking undelegate transaction.
+	UndelegateMethod = "undelegate"
+	// RedelegateMethod defines the ABI method name of the staking redelegate transaction.
+	RedelegateMethod = "redelegate"
+	// DelegationMethod defines the ABI method name of the delegation query.
+	DelegationMethod = "delegation"
+
+	// EventTypeDelegate defines the event emitted by the delegate transaction.
+	EventTypeDelegate = "Delegate"
+	// EventTypeUnbond defines the event emitted by the undelegate transaction.
+	EventTypeUnbond = "Unbond"
+	// EventTypeRedelegate defines the event emitted by the redelegate transaction.
+	EventTypeRedelegate = "Redelegate"
+)
+
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = Precompile{}
+
+// StakingKeeper defines the expected staking keeper interface.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool)
+}
+
+// Precompile is the stateful precompiled contract wrapping the staking module. The amounts
+// are expressed in the bond denomination.
+type Precompile struct {
+	cmn.Precompile
+
+	stakingKeeper StakingKeeper
+	authzKeeper   cmn.AuthzKeeper
+}
+
+// NewPrecompile creates the staking precompiled contract.
+func NewPrecompile(stakingKeeper StakingKeeper, authzKeeper cmn.AuthzKeeper) (Precompile, error) {
+	contractABI, err := cmn.LoadABI(abiJSON)
+	if err != nil {
+		return Precompile{}, err
+	}
+
+	return Precompile{
+		Precompile:    cmn.NewPrecompile(evmtypes.StakingPrecompileAddress, contractABI),
+		stakingKeeper: stakingKeeper,
+		authzKeeper:   authzKeeper,
+	}, nil
+}
+
+// RunStateful executes the staking method called by the contract input.
+func (p Precompile) RunStateful(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return p.RunMethod(evm, contract, readOnly, p.execute)
+}
+
+func (p Precompile) execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	switch method.Name {
+	case DelegateMethod:
+		return p.Delegate(ctx, evm, contract, method, args)
+	case UndelegateMethod:
+		return p.Undelegate(ctx, evm, contract, method, args)
+	case RedelegateMethod:
+		return p.Redelegate(ctx, evm, contract, method, args)
+	case DelegationMethod:
+		return p.Delegation(ctx, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+}
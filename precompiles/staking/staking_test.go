@@ -0,0 +1,211 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/constants"
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/precompiles/staking"
+	"github.com/servprotocolorg/serv/v12/precompiles/testutil"
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+)
+
+type PrecompileTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	precompile staking.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.PrecompileTestSuite.SetupTest()
+
+	precompile, err := staking.NewPrecompile(s.App.StakingKeeper, s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+}
+
+// newValidator creates a new bonded validator.
+func (s *PrecompileTestSuite) newValidator() stakingtypes.Validator {
+	valAddr := sdk.ValAddress(utiltx.GenerateAddress().Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	s.Require().NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(s.App.StakingKeeper, s.Ctx, validator, true)
+	s.Require().NoError(s.App.StakingKeeper.Hooks().AfterValidatorCreated(s.Ctx, validator.GetOperator()))
+	return validator
+}
+
+// delegation returns the tokens delegated by the given address to the given validator.
+func (s *PrecompileTestSuite) delegation(delegator common.Address, valAddr sdk.ValAddress) *big.Int {
+	input, err := s.precompile.Pack(staking.DelegationMethod, delegator, valAddr.String())
+	s.Require().NoError(err)
+	res := s.Call(s.Address, s.precompile.Address(), input)
+	s.Require().False(res.Failed(), res.VmError)
+
+	out, err := s.precompile.Unpack(staking.DelegationMethod, res.Ret)
+	s.Require().NoError(err)
+	s.Require().Len(out, 2)
+	balance := out[1].(struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	})
+	s.Require().Equal(constants.BaseDenom, balance.Denom)
+	return balance.Amount
+}
+
+func (s *PrecompileTestSuite) TestDelegate() {
+	amount := big.NewInt(1_000_000)
+
+	testCases := []struct {
+		name      string
+		malleate  func() (to common.Address)
+		expRevert bool
+	}{
+		{
+			"pass - delegator delegates its own tokens",
+			func() common.Address {
+				return s.precompile.Address()
+			},
+			false,
+		},
+		{
+			"fail - contract delegates the tokens of the delegator without authorization",
+			func() common.Address {
+				return s.DeployForwarder(s.precompile.Address())
+			},
+			true,
+		},
+		{
+			"pass - contract delegates the tokens of the delegator with a delegate authorization",
+			func() common.Address {
+				forwarder := s.DeployForwarder(s.precompile.Address())
+				limit := sdk.NewCoin(constants.BaseDenom, sdk.NewIntFromBigInt(amount))
+				authorization, err := stakingtypes.NewStakeAuthorization(
+					[]sdk.ValAddress{s.Validator.GetOperator()}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &limit,
+				)
+				s.Require().NoError(err)
+				expiration := s.Ctx.BlockTime().AddDate(1, 0, 0)
+				s.Require().NoError(s.App.AuthzKeeper.SaveGrant(s.Ctx, forwarder.Bytes(), s.Address.Bytes(), authorization, &expiration))
+				return forwarder
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			to := tc.malleate()
+			valAddr := s.Validator.GetOperator()
+
+			input, err := s.precompile.Pack(staking.DelegateMethod, s.Address, valAddr.String(), amount)
+			s.Require().NoError(err)
+			res := s.Call(s.Address, to, input)
+
+			if tc.expRevert {
+				s.Require().True(res.Failed())
+				s.Require().Zero(s.delegation(s.Address, valAddr).Sign())
+				return
+			}
+
+			s.Require().False(res.Failed(), res.VmError)
+			s.Require().Equal(amount, s.delegation(s.Address, valAddr))
+			balance := s.App.BankKeeper.GetBalance(s.Ctx, s.Address.Bytes(), constants.BaseDenom)
+			s.Require().Equal(testutil.DefaultBalance.Sub(sdk.NewIntFromBigInt(amount)).String(), balance.Amount.String())
+
+			s.Require().Len(res.Logs, 1)
+			s.Require().Equal(s.precompile.Events[staking.EventTypeDelegate].ID.Hex(), res.Logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(s.Address.Bytes()).Hex(), res.Logs[0].Topics[1])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUndelegateAndRedelegate() {
+	var (
+		amount  = big.NewInt(1_000_000)
+		valAddr = s.Validator.GetOperator()
+	)
+
+	input, err := s.precompile.Pack(staking.DelegateMethod, s.Address, valAddr.String(), amount)
+	s.Require().NoError(err)
+	s.Require().False(s.Call(s.Address, s.precompile.Address(), input).Failed())
+
+	s.Run("undelegate", func() {
+		undelegated := big.NewInt(400_000)
+		input, err := s.precompile.Pack(staking.UndelegateMethod, s.Address, valAddr.String(), undelegated)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().False(res.Failed(), res.VmError)
+
+		out, err := s.precompile.Unpack(staking.UndelegateMethod, res.Ret)
+		s.Require().NoError(err)
+		unbondingTime := s.App.StakingKeeper.UnbondingTime(s.Ctx)
+		s.Require().Equal(s.Ctx.BlockTime().Add(unbondingTime).Unix(), out[0])
+		s.Require().Equal(big.NewInt(600_000), s.delegation(s.Address, valAddr))
+
+		_, found := s.App.StakingKeeper.GetUnbondingDelegation(s.Ctx, s.Address.Bytes(), valAddr)
+		s.Require().True(found)
+		s.Require().Len(res.Logs, 1)
+		s.Require().Equal(s.precompile.Events[staking.EventTypeUnbond].ID.Hex(), res.Logs[0].Topics[0])
+	})
+
+	s.Run("redelegate", func() {
+		dstValidator := s.newValidator()
+		redelegated := big.NewInt(100_000)
+		input, err := s.precompile.Pack(
+			staking.RedelegateMethod, s.Address, valAddr.String(), dstValidator.GetOperator().String(), redelegated,
+		)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().False(res.Failed(), res.VmError)
+
+		s.Require().Equal(big.NewInt(500_000), s.delegation(s.Address, valAddr))
+		s.Require().Equal(redelegated, s.delegation(s.Address, dstValidator.GetOperator()))
+		s.Require().Len(res.Logs, 1)
+		s.Require().Equal(s.precompile.Events[staking.EventTypeRedelegate].ID.Hex(), res.Logs[0].Topics[0])
+	})
+
+	s.Run("fail - undelegate more than delegated", func() {
+		input, err := s.precompile.Pack(staking.UndelegateMethod, s.Address, valAddr.String(), amount)
+		s.Require().NoError(err)
+		res := s.Call(s.Address, s.precompile.Address(), input)
+		s.Require().True(res.Failed())
+		s.Require().Equal(big.NewInt(500_000), s.delegation(s.Address, valAddr))
+	})
+}
+
+func (s *PrecompileTestSuite) TestStaticCall() {
+	valAddr := s.Validator.GetOperator()
+	forwarder := s.DeployStaticForwarder(s.precompile.Address())
+
+	// queries can be executed through a static call
+	input, err := s.precompile.Pack(staking.DelegationMethod, s.Address, valAddr.String())
+	s.Require().NoError(err)
+	res := s.Call(s.Address, forwarder, input)
+	s.Require().False(res.Failed(), res.VmError)
+
+	// transactions are write protected, even for the calling contract itself
+	s.Require().NoError(s.App.BankKeeper.SendCoins(
+		s.Ctx, s.Address.Bytes(), forwarder.Bytes(), sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdk.NewInt(1))),
+	))
+	input, err = s.precompile.Pack(staking.DelegateMethod, forwarder, valAddr.String(), big.NewInt(1))
+	s.Require().NoError(err)
+	res = s.Call(s.Address, forwarder, input)
+	s.Require().True(res.Failed())
+	s.Require().Zero(s.delegation(forwarder, valAddr).Sign())
+
+	_, err = s.precompile.Run(input)
+	s.Require().ErrorIs(err, cmn.ErrStatefulOnly)
+}
@@ -0,0 +1,169 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/servprotocolorg/serv/v12/precompiles/common"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+)
+
+// Delegate delegates an amount of the bond denomination to a validator through a staking
+// MsgDelegate. The caller can only delegate for itself, unless the delegator granted it a
+// delegate authorization.
+func (p Precompile) Delegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("%w: expected 3, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := p.parseAmount(ctx, args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgDelegate(cmn.AccAddress(delegator), validator, amount)
+	if _, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvent(evm, p.Events[EventTypeDelegate], delegator, msg.ValidatorAddress, amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// Undelegate undelegates an amount of the bond denomination from a validator through a staking
+// MsgUndelegate, it returns the completion time of the unbonding as a unix timestamp. The caller
+// can only undelegate for itself, unless the delegator granted it an undelegate authorization.
+func (p Precompile) Undelegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("%w: expected 3, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, validator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := p.parseAmount(ctx, args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgUndelegate(cmn.AccAddress(delegator), validator, amount)
+	bz, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg)
+	if err != nil {
+		return nil, err
+	}
+	var res stakingtypes.MsgUndelegateResponse
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	completionTime := res.CompletionTime.UTC().Unix()
+
+	if err := p.EmitEvent(
+		evm, p.Events[EventTypeUnbond], delegator, msg.ValidatorAddress, amount.Amount.BigInt(), completionTime,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+// Redelegate moves an amount of the bond denomination delegated to a validator to another one
+// through a staking MsgBeginRedelegate, it returns the completion time of the redelegation as a
+// unix timestamp. The caller can only redelegate for itself, unless the delegator granted it a
+// redelegate authorization.
+func (p Precompile) Redelegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf("%w: expected 4, got %d", cmn.ErrInvalidArguments, len(args))
+	}
+	delegator, srcValidator, err := parseDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	dstValidator, err := parseValidator(args[2])
+	if err != nil {
+		return nil, err
+	}
+	amount, err := p.parseAmount(ctx, args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgBeginRedelegate(cmn.AccAddress(delegator), srcValidator, dstValidator, amount)
+	bz, err := cmn.DispatchMsg(ctx, p.authzKeeper, contract.Caller(), msg)
+	if err != nil {
+		return nil, err
+	}
+	var res stakingtypes.MsgBeginRedelegateResponse
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	completionTime := res.CompletionTime.UTC().Unix()
+
+	if err := p.EmitEvent(
+		evm, p.Events[EventTypeRedelegate], delegator, msg.ValidatorSrcAddress, msg.ValidatorDstAddress,
+		amount.Amount.BigInt(), completionTime,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+// parseDelegationArgs returns the delegator and validator addresses, which are the first
+// arguments of the staking methods.
+func parseDelegationArgs(args []interface{}) (common.Address, sdk.ValAddress, error) {
+	delegator, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("%w: invalid delegator address", cmn.ErrInvalidArguments)
+	}
+	validator, err := parseValidator(args[1])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return delegator, validator, nil
+}
+
+// parseValidator returns the validator address of a bech32 operator address argument.
+func parseValidator(arg interface{}) (sdk.ValAddress, error) {
+	bech32, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid validator address", cmn.ErrInvalidArguments)
+	}
+	return sdk.ValAddressFromBech32(bech32)
+}
+
+// parseAmount returns the bond denomination coin of an amount argument.
+func (p Precompile) parseAmount(ctx sdk.Context, arg interface{}) (sdk.Coin, error) {
+	amount, ok := arg.(*big.Int)
+	if !ok || amount == nil {
+		return sdk.Coin{}, fmt.Errorf("%w: invalid amount", cmn.ErrInvalidArguments)
+	}
+	return sdk.Coin{Denom: p.stakingKeeper.BondDenom(ctx), Amount: sdkmath.NewIntFromBigInt(amount)}, nil
+}
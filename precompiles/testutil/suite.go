@@ -0,0 +1,103 @@
+package testutil
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/app"
+	"github.com/servprotocolorg/serv/v12/constants"
+	"github.com/servprotocolorg/serv/v12/testutil"
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// DefaultBalance is the balance of the base denomination funded to the test accounts.
+var DefaultBalance = sdk.NewInt(1_000_000_000_000_000_000)
+
+// PrecompileTestSuite is the base suite of the precompiled contracts tests. It runs a chain
+// with a single bonded validator, which is the block proposer, and a funded account.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	App       *app.Serv
+	Ctx       sdk.Context
+	Address   common.Address
+	Validator stakingtypes.Validator
+}
+
+// SetupTest creates a new chain for each test.
+func (s *PrecompileTestSuite) SetupTest() {
+	chainID := constants.TestnetFullChainId
+	s.App = app.Setup(false, nil, chainID)
+	s.Ctx = s.App.BaseApp.NewContext(false, testutil.NewHeader(1, time.Now().UTC(), chainID, nil, nil, nil))
+
+	validators := s.App.StakingKeeper.GetAllValidators(s.Ctx)
+	s.Require().Len(validators, 1)
+	s.Validator = validators[0]
+	consAddr, err := s.Validator.GetConsAddr()
+	s.Require().NoError(err)
+	header := s.Ctx.BlockHeader()
+	header.ProposerAddress = consAddr
+	s.Ctx = s.Ctx.WithBlockHeader(header)
+
+	s.Address = s.NewFundedAddress()
+}
+
+// NewFundedAddress returns a new address funded with the default balance of the base denomination.
+func (s *PrecompileTestSuite) NewFundedAddress() common.Address {
+	addr := utiltx.GenerateAddress()
+	s.Require().NoError(testutil.FundAccount(
+		s.Ctx, s.App.BankKeeper, addr.Bytes(), sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, DefaultBalance)),
+	))
+	return addr
+}
+
+// Call executes and commits a call to the given address with the given input.
+func (s *PrecompileTestSuite) Call(from, to common.Address, input []byte) *evmtypes.MsgEthereumTxResponse {
+	k := s.App.EvmKeeper
+	config, err := k.EVMConfig(s.Ctx, s.Ctx.BlockHeader().ProposerAddress, k.ChainID())
+	s.Require().NoError(err)
+
+	msg := ethtypes.NewMessage(
+		from, &to, 0, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, true,
+	)
+	res, err := k.ApplyMessageWithConfig(s.Ctx, msg, nil, true, config, k.TxConfig(s.Ctx, common.Hash{}))
+	s.Require().NoError(err)
+	return res
+}
+
+// DeployForwarder deploys a contract forwarding its calldata to the given address and
+// returning, or reverting with, the call result. It's used to call a precompiled contract
+// from a contract.
+func (s *PrecompileTestSuite) DeployForwarder(target common.Address) common.Address {
+	code := common.FromHex("0x366000600037600060003660006000")
+	code = append(code, 0x73)
+	code = append(code, target.Bytes()...)
+	code = append(code, common.FromHex("0x5af13d600060003e6033573d6000fd5b3d6000f3")...)
+	return s.deploy(code)
+}
+
+// DeployStaticForwarder deploys a contract forwarding its calldata to the given address
+// through a static call.
+func (s *PrecompileTestSuite) DeployStaticForwarder(target common.Address) common.Address {
+	code := common.FromHex("0x36600060003760006000366000")
+	code = append(code, 0x73)
+	code = append(code, target.Bytes()...)
+	code = append(code, common.FromHex("0x5afa3d600060003e6031573d6000fd5b3d6000f3")...)
+	return s.deploy(code)
+}
+
+func (s *PrecompileTestSuite) deploy(code []byte) common.Address {
+	addr := utiltx.GenerateAddress()
+	vmdb := testutil.NewStateDB(s.Ctx, s.App.EvmKeeper)
+	vmdb.SetCode(addr, code)
+	s.Require().NoError(vmdb.Commit())
+	return addr
+}
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -37,7 +38,10 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs. The proofs of the height
+// verify against the app hash of the next block, see evmtypes.VerifyAccountProof,
+// evmtypes.VerifyBalanceProof and evmtypes.VerifyStorageProof for the proof format. The balance
+// is proven by the bank balance of the EVM denomination in BalanceProof.
 func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
@@ -82,7 +86,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   key,
 			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+			Proof: evmtypes.EncodeProof(proof),
 		}
 	}
 
@@ -103,6 +107,17 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, err
 	}
 
+	// query the balance proof of the EVM denomination
+	params, err := b.queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	balanceKey := banktypes.CreatePrefixedAccountStoreKey(address.Bytes(), []byte(params.Params.EvmDenom))
+	_, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
 	balance, ok := sdkmath.NewIntFromString(res.Balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
@@ -110,12 +125,13 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	return &rpctypes.AccountResult{
 		Address:      address,
-		AccountProof: GetHexProofs(proof),
+		AccountProof: evmtypes.EncodeProof(proof),
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
 		StorageHash:  common.Hash{}, // NOTE: Serv doesn't have a storage hash. TODO: implement?
 		StorageProof: storageProofs,
+		BalanceProof: evmtypes.EncodeProof(balanceProof),
 	}, nil
 }
 
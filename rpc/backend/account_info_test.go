@@ -11,6 +11,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -128,6 +129,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterAccount(queryClient, addr, bn.Int64())
+				RegisterParamsWithoutHeader(queryClient, bn.Int64())
 
 				// Use the IAVL height if a valid tendermint height is passed in.
 				iavlHeight := bn.Int64()
@@ -145,11 +147,18 @@ func (suite *BackendTestSuite) TestGetProof() {
 					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
 					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					banktypes.CreatePrefixedAccountStoreKey(address1.Bytes(), []byte(evmtypes.DefaultEVMDenom)),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
 				Address:      address1,
-				AccountProof: []string{""},
+				AccountProof: []string{},
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
@@ -158,9 +167,10 @@ func (suite *BackendTestSuite) TestGetProof() {
 					{
 						Key:   "0x0",
 						Value: (*hexutil.Big)(big.NewInt(2)),
-						Proof: []string{""},
+						Proof: []string{},
 					},
 				},
+				BalanceProof: []string{},
 			},
 		},
 	}
@@ -149,6 +149,7 @@ func (suite *BackendTestSuite) buildFormattedBlock(
 		transactions, fullTx,
 		receipts,
 		bloom,
+		common.Hash{},
 		common.BytesToAddress(validator.Bytes()),
 		suite.backend.logger,
 	)
@@ -297,7 +297,8 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", resBlock.Block.Height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	random := rpctypes.BlockRandomFromEvents(blockRes.EndBlockEvents)
+	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee, random)
 	return ethHeader, nil
 }
 
@@ -327,7 +328,8 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", resBlock.Block.Height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	random := rpctypes.BlockRandomFromEvents(blockRes.EndBlockEvents)
+	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee, random)
 	return ethHeader, nil
 }
 
@@ -490,6 +492,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		transactions, fullTx,
 		receipts,
 		bloom,
+		rpctypes.BlockRandomFromEvents(blockRes.EndBlockEvents),
 		validatorAddr,
 		b.logger,
 	)
@@ -535,7 +538,8 @@ func (b *Backend) EthBlockFromTendermintBlock(
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	random := rpctypes.BlockRandomFromEvents(blockRes.EndBlockEvents)
+	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee, random)
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -1313,6 +1313,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				transactions, tc.fullTx,
 				receipts,
 				bloom,
+				common.Hash{},
 				common.BytesToAddress(tc.validator.Bytes()),
 				log.NewNopLogger(),
 			)
@@ -1490,7 +1491,7 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 			header, err := suite.backend.HeaderByNumber(tc.blockNumber)
 
 			if tc.expPass {
-				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, ethtypes.Bloom{}, tc.baseFee)
+				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, ethtypes.Bloom{}, tc.baseFee, common.Hash{})
 				suite.Require().NoError(err)
 				suite.Require().Equal(expHeader, header)
 			} else {
@@ -1601,7 +1602,7 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 			header, err := suite.backend.HeaderByHash(tc.hash)
 
 			if tc.expPass {
-				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, ethtypes.Bloom{}, tc.baseFee)
+				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, ethtypes.Bloom{}, tc.baseFee, common.Hash{})
 				suite.Require().NoError(err)
 				suite.Require().Equal(expHeader, header)
 			} else {
@@ -1665,6 +1666,7 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 					emptyBlock.Header,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
+					common.Hash{},
 				),
 				[]*ethtypes.Transaction{},
 				nil,
@@ -1692,6 +1694,7 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 					emptyBlock.Header,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
+					common.Hash{},
 				),
 				[]*ethtypes.Transaction{msgEthereumTx.AsTransaction()},
 				nil,
@@ -1756,6 +1759,7 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 					emptyBlock.Header,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
+					common.Hash{},
 				),
 				[]*ethtypes.Transaction{},
 				nil,
@@ -1791,6 +1795,7 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 					emptyBlock.Header,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
+					common.Hash{},
 				),
 				[]*ethtypes.Transaction{msgEthereumTx.AsTransaction()},
 				nil,
@@ -95,14 +95,22 @@ func (b *Backend) CurrentHeader() *ethtypes.Header {
 	return header
 }
 
+// maxPendingTransactions is the max number of transactions of the CometBFT unconfirmed_txs
+// endpoint, it's the max page size of the CometBFT RPC and the endpoint doesn't support paging.
+const maxPendingTransactions = 100
+
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
+// At most maxPendingTransactions transactions are returned, the CometBFT RPC doesn't
+// expose the rest of the mempool.
 func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
-	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	// without limit the endpoint returns the default page of 30 transactions
+	limit := maxPendingTransactions
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
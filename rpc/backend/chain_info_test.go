@@ -12,6 +12,7 @@ import (
 
 	"github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
 	rpc "github.com/servprotocolorg/serv/v12/rpc/types"
@@ -465,3 +466,52 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestPendingTransactions() {
+	_, bz := suite.buildEthereumTx()
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expLen       int
+		expPass      bool
+	}{
+		{
+			"fail - unconfirmed txs returns error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client)
+			},
+			0,
+			false,
+		},
+		{
+			"pass - more txs than the default page of the unconfirmed txs",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				txs := make([]tmtypes.Tx, 40)
+				for i := range txs {
+					txs[i] = bz
+				}
+				RegisterUnconfirmedTxs(client, txs)
+			},
+			40,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			txs, err := suite.backend.PendingTransactions()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(txs, tc.expLen)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -58,20 +58,22 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 }
 
 // Unconfirmed Transactions
-func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+var pendingTransactionsLimit = maxPendingTransactions
+
+func RegisterUnconfirmedTxs(client *mocks.Client, txs []types.Tx) {
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &pendingTransactionsLimit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
 }
 
-func RegisterUnconfirmedTxsEmpty(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsEmpty(client *mocks.Client) {
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &pendingTransactionsLimit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{
 			Txs: make([]types.Tx, 2),
 		}, nil)
 }
 
-func RegisterUnconfirmedTxsError(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsError(client *mocks.Client) {
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &pendingTransactionsLimit).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
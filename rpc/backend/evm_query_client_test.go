@@ -60,11 +60,35 @@ func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEt
 		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
 }
 
+func RegisterTraceBlockStateDiff(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, data []byte) {
+	traceConfig := &evmtypes.TraceConfig{Tracer: rpc.StateDiffTracer, TracerJsonConfig: rpc.StateDiffTracerConfig}
+	queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: traceConfig, ChainId: constants.TestnetEIP155ChainId, BlockMaxGas: -1}).
+		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
+}
+
+func RegisterTraceBlockCalls(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, data []byte) {
+	traceConfig := &evmtypes.TraceConfig{Tracer: rpc.CallTracer}
+	queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: traceConfig, ChainId: constants.TestnetEIP155ChainId, BlockMaxGas: -1}).
+		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
+}
+
 func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("TraceBlock", rpc.ContextWithHeight(1), &evmtypes.QueryTraceBlockRequest{}).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest, data []byte) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
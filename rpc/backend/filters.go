@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	evertypes "github.com/servprotocolorg/serv/v12/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -34,3 +36,33 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// GetLogsFromIndex returns the logs of the [from, to] block range matching the addresses and
+// topics criteria from the log index of the indexer. Returns false if the indexer doesn't index
+// the logs or the range isn't covered by the log index.
+func (b *Backend) GetLogsFromIndex(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, bool, error) {
+	logIndexer, ok := b.indexer.(evertypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+
+	first, last, err := logIndexer.LogIndexedRange()
+	if err != nil {
+		b.logger.Debug("failed to load the log indexed range", "error", err.Error())
+		return nil, false, nil
+	}
+	if first < 0 || from < first || to > last {
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.GetLogs(from, to, addresses, topics, limit)
+	if err != nil {
+		return nil, true, err
+	}
+	return logs, true, nil
+}
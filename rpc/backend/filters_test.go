@@ -2,10 +2,15 @@ package backend
 
 import (
 	"encoding/json"
+	"math/big"
 
+	"github.com/servprotocolorg/serv/v12/indexer"
 	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
 	ethrpc "github.com/servprotocolorg/serv/v12/rpc/types"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -120,3 +125,54 @@ func (suite *BackendTestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetLogsFromIndex() {
+	addr := common.BigToAddress(big.NewInt(1))
+	log := &ethtypes.Log{Address: addr, Topics: []common.Hash{common.BigToHash(big.NewInt(1))}, BlockNumber: 2}
+	bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+	suite.Require().NoError(err)
+	results := []*abci.ResponseDeliverTx{
+		{Code: 0, Events: []abci.Event{
+			{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)},
+			}},
+		}},
+	}
+
+	testCases := []struct {
+		name       string
+		indexLogs  bool
+		from, to   int64
+		limit      int
+		expLogs    []*ethtypes.Log
+		expIndexed bool
+		expPass    bool
+	}{
+		{"pass - the indexer doesn't index the logs", false, 1, 2, 10, nil, false, true},
+		{"pass - range not covered", true, 1, 3, 10, nil, false, true},
+		{"pass - logs from the index", true, 2, 3, 10, []*ethtypes.Log{log}, true, true},
+		{"fail - limit exceeded", true, 2, 3, 0, nil, true, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.indexLogs {
+				idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+				suite.Require().NoError(idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 2}}, results))
+				suite.Require().NoError(idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 3}}, nil))
+				suite.backend.indexer = idxer
+			}
+
+			logs, indexed, err := suite.backend.GetLogsFromIndex(tc.from, tc.to, []common.Address{addr}, nil, tc.limit)
+			suite.Require().Equal(tc.expIndexed, indexed)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expLogs, logs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -170,11 +170,59 @@ func (_m *EVMTxIndexer) LastIndexedBlock() (int64, error) {
 	return r0, r1
 }
 
+// MarkBlockSkipped provides a mock function with given fields: _a0
+func (_m *EVMTxIndexer) MarkBlockSkipped(_a0 int64) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkBlockSkipped")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *EVMTxIndexer) Ready() {
 	_m.Called()
 }
 
+// SkippedBlocks provides a mock function with given fields:
+func (_m *EVMTxIndexer) SkippedBlocks() ([]int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkippedBlocks")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewEVMTxIndexer interface {
 	mock.TestingT
 	Cleanup(func())
@@ -205,3 +205,231 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall returns the structured logs created during the execution of the given call on
+// top of the state of the requested block, with the state and block overrides of the config.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil || blk == nil || blk.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+		if traceCallRequest.Overrides, err = marshalStateOverrides(config.StateOverrides); err != nil {
+			return nil, err
+		}
+		if config.BlockOverrides != nil {
+			if traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+	return decodedResult, nil
+}
+
+// IntermediateRoots re-executes the transactions of the block with the given hash and returns
+// the commitment of the state diff of each of them. The IAVL store doesn't compute a state
+// root per transaction, so the commitment is the hash of the accounts and storage slots
+// touched by the transaction, see rpctypes.StateDiff.
+func (b *Backend) IntermediateRoots(hash common.Hash, config *evmtypes.TraceConfig) ([]common.Hash, error) {
+	resBlock, err := b.TendermintBlockByHash(hash)
+	if err != nil {
+		b.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	traceConfig := &evmtypes.TraceConfig{
+		Tracer:           rpctypes.StateDiffTracer,
+		TracerJsonConfig: rpctypes.StateDiffTracerConfig,
+	}
+	if config != nil {
+		traceConfig.Timeout = config.Timeout
+	}
+
+	results, err := b.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), traceConfig, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d: %s", i, result.Error)
+		}
+
+		bz, err := json.Marshal(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		var diff rpctypes.StateDiff
+		if err := json.Unmarshal(bz, &diff); err != nil {
+			return nil, err
+		}
+		if roots[i], err = diff.Hash(); err != nil {
+			return nil, err
+		}
+	}
+	return roots, nil
+}
+
+// TraceTransactionCalls returns the Parity style call traces of the given transaction.
+func (b *Backend) TraceTransactionCalls(hash common.Hash) ([]*rpctypes.FlatCallTrace, error) {
+	result, err := b.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: rpctypes.CallTracer})
+	if err != nil {
+		return nil, err
+	}
+	return decodeCallTraces(result)
+}
+
+// TraceBlockCalls returns the Parity style call traces of the Ethereum transactions of the
+// given block, one list per transaction. It returns nil if the block is not found.
+func (b *Backend) TraceBlockCalls(blockNum rpctypes.BlockNumber) ([][]*rpctypes.FlatCallTrace, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("get block failed", "height", blockNum, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	traceConfig := &evmtypes.TraceConfig{Tracer: rpctypes.CallTracer}
+	results, err := b.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), traceConfig, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([][]*rpctypes.FlatCallTrace, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d: %s", i, result.Error)
+		}
+		if traces[i], err = decodeCallTraces(result.Result); err != nil {
+			return nil, err
+		}
+	}
+	return traces, nil
+}
+
+// TraceFilter returns the Parity style call traces of the given block range matching the
+// filter. The range defaults to the latest block and is bounded by the block range cap.
+func (b *Backend) TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatCallTrace, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from := traceFilterHeight(args.FromBlock, int64(head))
+	to := traceFilterHeight(args.ToBlock, int64(head))
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d is greater than to %d", from, to)
+	}
+	if blockLimit := int64(b.RPCBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var after, count uint64 = 0, math.MaxUint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	traces := []*rpctypes.FlatCallTrace{}
+	if count == 0 {
+		return traces, nil
+	}
+
+	var skipped uint64
+	for height := from; height <= to; height++ {
+		blockTraces, err := b.TraceBlockCalls(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to trace block %d", height)
+		}
+		for _, txTraces := range blockTraces {
+			for _, trace := range txTraces {
+				if !args.Matches(trace) {
+					continue
+				}
+				if skipped < after {
+					skipped++
+					continue
+				}
+				traces = append(traces, trace)
+				if uint64(len(traces)) == count {
+					return traces, nil
+				}
+			}
+		}
+	}
+	return traces, nil
+}
+
+// traceFilterHeight returns the height of a trace_filter bound, the latest and pending
+// blocks resolve to the head and the genesis block, which isn't traceable, to the first block.
+func traceFilterHeight(blockNum *rpctypes.BlockNumber, head int64) int64 {
+	switch {
+	case blockNum == nil || *blockNum < 0:
+		return head
+	case *blockNum == rpctypes.EthEarliestBlockNumber:
+		return 1
+	default:
+		return blockNum.Int64()
+	}
+}
+
+// decodeCallTraces decodes the result of the flat call tracer.
+func decodeCallTraces(result interface{}) ([]*rpctypes.FlatCallTrace, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var traces []*rpctypes.FlatCallTrace
+	if err := json.Unmarshal(bz, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
@@ -1,11 +1,14 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/servprotocolorg/serv/v12/crypto/ethsecp256k1"
 	"github.com/servprotocolorg/serv/v12/indexer"
 	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
+	rpctypes "github.com/servprotocolorg/serv/v12/rpc/types"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -14,6 +17,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -261,3 +265,317 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestIntermediateRoots() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	addr := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	nonce := uint64(1)
+	diff := rpctypes.StateDiff{
+		Pre:  map[common.Address]*rpctypes.StateDiffAccount{addr: {Balance: (*hexutil.Big)(big.NewInt(2))}},
+		Post: map[common.Address]*rpctypes.StateDiffAccount{addr: {Balance: (*hexutil.Big)(big.NewInt(1)), Nonce: &nonce}},
+	}
+	root, err := diff.Hash()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expRoots     []common.Hash
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashNotFound(client, common.Hash{}, bz)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - transaction trace error",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceBlockStateDiff(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, []byte(`[{"error":"tracer not found"}]`))
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - state diff commitment of each transaction",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				data, err := json.Marshal([]*evmtypes.TxTraceResult{{Result: diff}})
+				suite.Require().NoError(err)
+				RegisterTraceBlockStateDiff(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, data)
+			},
+			[]common.Hash{root},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			roots, err := suite.backend.IntermediateRoots(common.Hash{}, nil)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRoots, roots)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	toAddr := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.BlockNumber(1)
+	number := (*hexutil.Big)(big.NewInt(100))
+	config := &rpctypes.TraceCallConfig{
+		TraceConfig:    evmtypes.TraceConfig{Tracer: evmtypes.TracerCall},
+		BlockOverrides: &rpctypes.BlockOverrides{Number: number},
+	}
+	blockOverridesBz, err := json.Marshal(config.BlockOverrides)
+	suite.Require().NoError(err)
+
+	request := &evmtypes.QueryTraceCallRequest{
+		Args:           argsBz,
+		GasCap:         suite.backend.RPCGasCap(),
+		ChainId:        suite.backend.chainID.Int64(),
+		TraceConfig:    &config.TraceConfig,
+		BlockOverrides: blockOverridesBz,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - trace call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - trace result",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, request, []byte(`{"type":"CALL"}`))
+			},
+			map[string]interface{}{"type": "CALL"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.TraceCall(callArgs, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, config)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceBlockCalls() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	from := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	trace := &rpctypes.FlatCallTrace{
+		Action:       rpctypes.FlatCallAction{CallType: "call", From: &from, To: &from},
+		Subtraces:    0,
+		TraceAddress: []int{},
+		Type:         "call",
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expTraces    [][]*rpctypes.FlatCallTrace
+		expPass      bool
+	}{
+		{
+			"fail - block error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - transaction trace error",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceBlockCalls(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, []byte(`[{"error":"tracer not found"}]`))
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - call traces of each transaction",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				data, err := json.Marshal([]*evmtypes.TxTraceResult{{Result: []*rpctypes.FlatCallTrace{trace}}})
+				suite.Require().NoError(err)
+				RegisterTraceBlockCalls(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, data)
+			},
+			[][]*rpctypes.FlatCallTrace{{trace}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traces, err := suite.backend.TraceBlockCalls(rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraces, traces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceFilter() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	from := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	traces := []*rpctypes.FlatCallTrace{
+		{Action: rpctypes.FlatCallAction{CallType: "call", From: &from, To: &to}, Subtraces: 1, TraceAddress: []int{}, Type: "call"},
+		{Action: rpctypes.FlatCallAction{CallType: "call", From: &to, To: &from}, TraceAddress: []int{0}, Type: "call"},
+	}
+	blockNr, nextBlockNr := rpctypes.BlockNumber(1), rpctypes.BlockNumber(2)
+	one := uint64(1)
+
+	registerBlockTraces := func() {
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+		RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
+		_, err := RegisterBlock(client, 1, bz)
+		suite.Require().NoError(err)
+		RegisterConsensusParams(client, 1)
+		data, err := json.Marshal([]*evmtypes.TxTraceResult{{Result: traces}})
+		suite.Require().NoError(err)
+		RegisterTraceBlockCalls(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, data)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         rpctypes.TraceFilterArgs
+		expTraces    []*rpctypes.FlatCallTrace
+		expPass      bool
+	}{
+		{
+			"fail - block range over the cap",
+			func() {
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				RegisterIndexerGetLastRequestIndexedBlock(indexer, 3)
+				suite.backend.cfg.JSONRPC.BlockRangeCap = 1
+			},
+			rpctypes.TraceFilterArgs{FromBlock: &blockNr},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid block range",
+			func() {
+				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
+				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
+			},
+			rpctypes.TraceFilterArgs{FromBlock: &nextBlockNr, ToBlock: &blockNr},
+			nil,
+			false,
+		},
+		{
+			"pass - all the traces of the latest block",
+			registerBlockTraces,
+			rpctypes.TraceFilterArgs{},
+			traces,
+			true,
+		},
+		{
+			"pass - traces filtered by sender",
+			registerBlockTraces,
+			rpctypes.TraceFilterArgs{FromBlock: &blockNr, FromAddress: []common.Address{to}},
+			traces[1:],
+			true,
+		},
+		{
+			"pass - traces paginated",
+			registerBlockTraces,
+			rpctypes.TraceFilterArgs{After: &one, Count: &one},
+			traces[1:],
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.TraceFilter(tc.args)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraces, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -232,6 +232,100 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 	)
 }
 
+// GetBlockReceipts returns the receipts of all the Ethereum transactions of the block
+// identified by number or hash. The receipts are built from a single query of the block
+// results and the transactions indexed by the EVM indexer.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCReceipt, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		b.logger.Debug("block not found", "block number or hash", blockNrOrHash, "error", err.Error())
+		return nil, nil
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum.Int64())
+		return nil, nil
+	}
+
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	// gas used by the cosmos transactions of the block preceding each transaction
+	precedingGasUsed := make([]uint64, len(blockRes.TxsResults)+1)
+	for i, txResult := range blockRes.TxsResults {
+		precedingGasUsed[i+1] = precedingGasUsed[i] + uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+	}
+
+	var (
+		baseFee        *big.Int
+		baseFeeFetched bool
+		blockHash      = common.BytesToHash(resBlock.BlockID.Hash.Bytes())
+	)
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]*rpctypes.RPCReceipt, 0, len(msgs))
+	for i, ethMsg := range msgs {
+		res, err := b.GetTxByEthHash(common.HexToHash(ethMsg.Hash))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get indexed tx %s: %w", ethMsg.Hash, err)
+		}
+		if res.Height != height || int(res.TxIndex) >= len(blockRes.TxsResults) {
+			return nil, fmt.Errorf("invalid indexed tx %s at height %d, index %d", ethMsg.Hash, res.Height, res.TxIndex)
+		}
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			b.logger.Error("failed to unpack tx data", "error", err.Error())
+			return nil, err
+		}
+
+		// parse tx logs from events
+		msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
+		logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+		if err != nil {
+			b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+		}
+
+		if !baseFeeFetched && ethMsg.AsTransaction().Type() == uint8(ethtypes.DynamicFeeTxType) {
+			baseFeeFetched = true
+			baseFee, err = b.BaseFee(blockRes)
+			if err != nil {
+				// tolerate the error for pruned node.
+				b.logger.Error("fetch basefee failed, node is pruned?", "height", height, "error", err)
+			}
+		}
+
+		receipt, err := rpctypes.NewRPCReceipt(
+			ethMsg,
+			hexutil.Uint64(i),
+			!res.Failed,
+			hexutil.Uint64(b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas())),
+			hexutil.Uint64(precedingGasUsed[res.TxIndex]+res.CumulativeGasUsed),
+			baseFee,
+			logs,
+			blockHash,
+			hexutil.Uint64(height),
+			chainID.ToInt(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
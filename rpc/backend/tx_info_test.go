@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func (suite *BackendTestSuite) TestGetTransactionByHash() {
@@ -142,7 +143,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Pending transactions returns error",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 			},
 			msgEthereumTx,
 			nil,
@@ -152,7 +153,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Tx not found return nil",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterUnconfirmedTxs(client, nil)
 			},
 			msgEthereumTx,
 			nil,
@@ -162,7 +163,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"pass - Tx found and returned",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterUnconfirmedTxs(client, types.Txs{bz})
 			},
 			msgEthereumTx,
 			rpcTransaction,
@@ -551,6 +552,104 @@ func (suite *BackendTestSuite) TestQueryTendermintTxIndexer() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	blockNr := rpctypes.BlockNumber(1)
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  int
+		expPass      bool
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			0,
+			true,
+		},
+		{
+			"pass - block results not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			0,
+			true,
+		},
+		{
+			"pass - receipts of the block transactions",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, blockResult)
+			suite.Require().NoError(err)
+			suite.backend.indexer.Ready()
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, tc.expReceipts)
+			for i, receipt := range receipts {
+				suite.Require().Equal(txHash, receipt.TransactionHash)
+				suite.Require().Equal(hexutil.Uint64(i), receipt.TransactionIndex)
+				suite.Require().Equal(hexutil.Uint64(1), receipt.BlockNumber)
+				suite.Require().Equal(hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt.Status)
+
+				res, err := suite.backend.GetTxByEthHash(txHash)
+				suite.Require().NoError(err)
+				suite.Require().Equal(hexutil.Uint64(res.GasUsed), receipt.GasUsed)
+				suite.Require().Equal(hexutil.Uint64(res.CumulativeGasUsed), receipt.CumulativeGasUsed)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	msgEthereumTx, _ := suite.buildEthereumTx()
 	txHash := msgEthereumTx.AsTransaction().Hash()
@@ -0,0 +1,109 @@
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/servprotocolorg/serv/v12/rpc/types"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// TxPoolContent returns the unconfirmed Ethereum transactions of the mempool, grouped by
+// sender and nonce. Similar to geth, the transactions which can be executed right after the
+// current sequence of the sender are reported as pending while the ones following a nonce
+// gap are reported as queued.
+func (b *Backend) TxPoolContent() (pending, queued rpctypes.TxPoolTransactions, err error) {
+	return b.txPoolContent(nil)
+}
+
+// TxPoolContentFrom returns the pending and queued transactions of the given sender.
+func (b *Backend) TxPoolContentFrom(addr common.Address) (pending, queued rpctypes.TxPoolTransactions, err error) {
+	return b.txPoolContent(&addr)
+}
+
+// txPoolContent collects the Ethereum transactions of the mempool, optionally only the ones
+// sent by the given address, and groups them into pending and queued transactions.
+func (b *Backend) txPoolContent(from *common.Address) (pending, queued rpctypes.TxPoolTransactions, err error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rpcTxs := make([]*rpctypes.RPCTransaction, 0, len(txs))
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				b.chainID,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			if from != nil && rpcTx.From != *from {
+				continue
+			}
+			rpcTxs = append(rpcTxs, rpcTx)
+		}
+	}
+
+	return groupTxPoolTransactions(rpcTxs, func(addr common.Address) (uint64, error) {
+		// query the committed sequence of the sender at the latest height
+		return b.getAccountNonce(addr, false, 0, b.logger)
+	})
+}
+
+// groupTxPoolTransactions splits the given transactions into pending and queued ones.
+// For each sender, the transactions are sorted by nonce and, starting from the committed
+// nonce returned by getNonce, every transaction without nonce gap is pending, the rest are queued.
+// Like geth, the transactions with a nonce below the committed nonce are dropped as they can't
+// be executed.
+func groupTxPoolTransactions(
+	txs []*rpctypes.RPCTransaction, getNonce func(common.Address) (uint64, error),
+) (pending, queued rpctypes.TxPoolTransactions, err error) {
+	bySender := make(map[common.Address][]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		bySender[tx.From] = append(bySender[tx.From], tx)
+	}
+
+	pending = make(rpctypes.TxPoolTransactions)
+	queued = make(rpctypes.TxPoolTransactions)
+	for sender, senderTxs := range bySender {
+		committedNonce, err := getNonce(sender)
+		if err != nil {
+			return nil, nil, err
+		}
+		nextNonce := committedNonce
+
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Nonce < senderTxs[j].Nonce
+		})
+
+		for _, tx := range senderTxs {
+			nonce := uint64(tx.Nonce)
+			if nonce < committedNonce {
+				continue
+			}
+			if nonce > nextNonce {
+				queued.Add(tx)
+				continue
+			}
+			pending.Add(tx)
+			if nonce == nextNonce {
+				nextNonce++
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
@@ -0,0 +1,102 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/servprotocolorg/serv/v12/rpc/types"
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+)
+
+func (suite *BackendTestSuite) TestGroupTxPoolTransactions() {
+	sender1 := utiltx.GenerateAddress()
+	sender2 := utiltx.GenerateAddress()
+
+	newTx := func(from common.Address, nonce uint64) *rpctypes.RPCTransaction {
+		return &rpctypes.RPCTransaction{From: from, Nonce: hexutil.Uint64(nonce)}
+	}
+
+	testCases := []struct {
+		name       string
+		txs        []*rpctypes.RPCTransaction
+		nonces     map[common.Address]uint64
+		expPending map[common.Address][]uint64
+		expQueued  map[common.Address][]uint64
+		expPass    bool
+	}{
+		{
+			"pass - empty pool",
+			nil,
+			nil,
+			map[common.Address][]uint64{},
+			map[common.Address][]uint64{},
+			true,
+		},
+		{
+			"pass - sequential nonces are pending",
+			[]*rpctypes.RPCTransaction{newTx(sender1, 6), newTx(sender1, 5), newTx(sender2, 0)},
+			map[common.Address]uint64{sender1: 5, sender2: 0},
+			map[common.Address][]uint64{sender1: {5, 6}, sender2: {0}},
+			map[common.Address][]uint64{},
+			true,
+		},
+		{
+			"pass - transactions after a nonce gap are queued",
+			[]*rpctypes.RPCTransaction{newTx(sender1, 1), newTx(sender1, 3), newTx(sender1, 4), newTx(sender2, 2)},
+			map[common.Address]uint64{sender1: 1, sender2: 0},
+			map[common.Address][]uint64{sender1: {1}},
+			map[common.Address][]uint64{sender1: {3, 4}, sender2: {2}},
+			true,
+		},
+		{
+			"pass - transactions below the committed nonce are dropped",
+			[]*rpctypes.RPCTransaction{newTx(sender1, 3), newTx(sender1, 4), newTx(sender1, 5), newTx(sender2, 0)},
+			map[common.Address]uint64{sender1: 5, sender2: 1},
+			map[common.Address][]uint64{sender1: {5}},
+			map[common.Address][]uint64{},
+			true,
+		},
+		{
+			"fail - unable to get the account nonce",
+			[]*rpctypes.RPCTransaction{newTx(sender1, 0)},
+			nil,
+			nil,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			pending, queued, err := groupTxPoolTransactions(tc.txs, func(addr common.Address) (uint64, error) {
+				nonce, found := tc.nonces[addr]
+				if !found {
+					return 0, errors.New("account not found")
+				}
+				return nonce, nil
+			})
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for _, group := range []struct {
+				txs rpctypes.TxPoolTransactions
+				exp map[common.Address][]uint64
+			}{{pending, tc.expPending}, {queued, tc.expQueued}} {
+				suite.Require().Len(group.txs, len(group.exp))
+				for sender, nonces := range group.exp {
+					suite.Require().Len(group.txs[sender], len(nonces))
+					for _, nonce := range nonces {
+						suite.Require().Contains(group.txs[sender], nonce)
+					}
+				}
+			}
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 
 	"github.com/servprotocolorg/serv/v12/rpc/types"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
-	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 )
 
 type txGasAndReward struct {
@@ -278,19 +277,11 @@ func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*etht
 	return blockLogs, nil
 }
 
-// GetHexProofs returns list of hex data of proof op
-func GetHexProofs(proof *crypto.ProofOps) []string {
-	if proof == nil {
-		return []string{""}
+// marshalStateOverrides encodes the optional state overrides of a call into the
+// json format expected by the evm module queries.
+func marshalStateOverrides(overrides *types.StateOverride) ([]byte, error) {
+	if overrides == nil {
+		return nil, nil
 	}
-	proofs := []string{}
-	// check for proof
-	for _, p := range proof.Ops {
-		proof := ""
-		if len(p.Data) > 0 {
-			proof = hexutil.Encode(p.Data)
-		}
-		proofs = append(proofs, proof)
-	}
-	return proofs
+	return json.Marshal(overrides)
 }
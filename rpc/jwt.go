@@ -0,0 +1,118 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// jwtSecretLength is the length in bytes of the JWT secret.
+	jwtSecretLength = 32
+
+	// jwtIssuedAtTolerance is the max difference between the `iat` claim of a token and the local
+	// time, like the geth authenticated RPC it prevents the replay of old tokens.
+	jwtIssuedAtTolerance = 60 * time.Second
+
+	errCodeUnauthorized = -32001
+)
+
+// JWTAuth authenticates the requests of the namespaces requiring authentication with the
+// HS256 JWT tokens of the `Authorization: Bearer <token>` header, signed with the shared secret.
+type JWTAuth struct {
+	secret     []byte
+	namespaces map[string]bool
+}
+
+// NewJWTAuth creates the authentication of the namespaces with the JWT secret.
+func NewJWTAuth(secret []byte, namespaces []string) *JWTAuth {
+	a := &JWTAuth{secret: secret, namespaces: make(map[string]bool)}
+	for _, ns := range namespaces {
+		a.namespaces[ns] = true
+	}
+	return a
+}
+
+// LoadOrGenerateJWTSecret loads the hex encoded JWT secret of the file, a random secret is
+// generated and saved if the file doesn't exist.
+func LoadOrGenerateJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	switch {
+	case err == nil:
+		secret, err := hexutil.Decode(strings.TrimSpace(string(data)))
+		if err != nil {
+			// the secret is also accepted without 0x prefix
+			secret, err = hexutil.Decode("0x" + strings.TrimSpace(string(data)))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT secret in %s: %w", path, err)
+		}
+		if len(secret) != jwtSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret length in %s, expect %d bytes, got %d", path, jwtSecretLength, len(secret))
+		}
+		return secret, nil
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	secret := make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// requiresAuth returns true if the namespace of the method requires the authentication.
+func (a *JWTAuth) requiresAuth(method string) bool {
+	ns, _, _ := strings.Cut(method, "_")
+	return a.namespaces[ns]
+}
+
+// authenticate verifies the JWT token of the Authorization header of the request.
+func (a *JWTAuth) authenticate(r *http.Request) error {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return errors.New("missing token")
+	}
+	return a.verifyToken(token, time.Now())
+}
+
+// verifyToken verifies the HS256 signature and the `iat` claim of the token.
+func (a *JWTAuth) verifyToken(token string, now time.Time) error {
+	// the claims are verified against the given time below
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"HS256"}), jwt.WithoutClaimsValidation())
+	claims := new(jwt.RegisteredClaims)
+	if _, err := parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return a.secret, nil
+	}); err != nil {
+		return err
+	}
+
+	switch {
+	case claims.IssuedAt == nil:
+		return errors.New("missing issued-at")
+	case now.Sub(claims.IssuedAt.Time) > jwtIssuedAtTolerance:
+		return errors.New("stale token")
+	case claims.IssuedAt.Time.Sub(now) > jwtIssuedAtTolerance:
+		return errors.New("future token")
+	case !claims.VerifyExpiresAt(now, false):
+		return errors.New("token is expired")
+	case !claims.VerifyNotBefore(now, false):
+		return errors.New("token is not valid yet")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newJWTToken returns a HS256 JWT token of the claims signed with the secret.
+func newJWTToken(secret []byte, alg, claims string) string {
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"alg":"%s","typ":"JWT"}`, alg))) +
+		"." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTAuthVerifyToken(t *testing.T) {
+	secret := make([]byte, jwtSecretLength)
+	secret[0] = 1
+	auth := NewJWTAuth(secret, []string{"admin"})
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		token    string
+		expError bool
+	}{
+		{"valid token", newJWTToken(secret, "HS256", fmt.Sprintf(`{"iat":%d}`, now.Unix())), false},
+		{"valid token with expiry", newJWTToken(secret, "HS256", fmt.Sprintf(`{"iat":%d,"exp":%d}`, now.Unix(), now.Unix()+10)), false},
+		{"issued in the tolerance", newJWTToken(secret, "HS256", fmt.Sprintf(`{"iat":%d}`, now.Unix()-50)), false},
+		{"stale token", newJWTToken(secret, "HS256", fmt.Sprintf(`{"iat":%d}`, now.Unix()-120)), true},
+		{"token issued in the future", newJWTToken(secret, "HS256", fmt.Sprintf(`{"iat":%d}`, now.Unix()+120)), true},
+		{"expired token", newJWTToken(secret, "HS256", fmt.Sprintf(`{"iat":%d,"exp":%d}`, now.Unix(), now.Unix())), true},
+		{"missing issued at", newJWTToken(secret, "HS256", `{}`), true},
+		{"other secret", newJWTToken([]byte("other"), "HS256", fmt.Sprintf(`{"iat":%d}`, now.Unix())), true},
+		{"other algorithm", newJWTToken(secret, "none", fmt.Sprintf(`{"iat":%d}`, now.Unix())), true},
+		{"malformed token", "token", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := auth.verifyToken(tc.token, now)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.True(t, auth.requiresAuth("admin_peers"))
+	require.False(t, auth.requiresAuth("eth_chainId"))
+	require.False(t, auth.requiresAuth("administrator_peers"))
+}
+
+func TestLoadOrGenerateJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "jwtsecret")
+
+	// generated if missing
+	secret, err := LoadOrGenerateJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, jwtSecretLength)
+
+	loaded, err := LoadOrGenerateJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	// the secret is accepted without 0x prefix
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("%x\n", secret)), 0o600))
+	loaded, err = LoadOrGenerateJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = LoadOrGenerateJWTSecret(path)
+	require.Error(t, err)
+}
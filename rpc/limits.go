@@ -0,0 +1,368 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/servprotocolorg/serv/v12/server/config"
+)
+
+const (
+	// forwardedRequestHeader is set on the requests the websocket server forwards to the HTTP
+	// server, their rate limits and authentication are already applied by the websocket server.
+	forwardedRequestHeader = "X-Websocket-Forwarded"
+
+	// rateLimiterCleanupInterval is the interval between the removals of the idle buckets.
+	rateLimiterCleanupInterval = time.Minute
+
+	// maxRequestContentLength is the max size of the request body, it's the limit of the geth
+	// HTTP server which is only checked after the body is read by the limits.
+	maxRequestContentLength = 5 * 1024 * 1024
+
+	errCodeInvalidRequest    = -32600
+	errCodeMethodNotFound    = -32601
+	errCodeResponseTooLarge  = -32003
+	errCodeRateLimitExceeded = -32005
+)
+
+// limitError is the JSON-RPC error of a request rejected by the limits.
+type limitError struct {
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	httpStatus int
+}
+
+type limitErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *limitError     `json:"error"`
+}
+
+// rpcRequest is the part of a JSON-RPC request the limits depend on.
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// RequestLimiter enforces the batch, response size, rate limits, the allowed methods and the
+// authentication of the JSON-RPC server, they're shared by the HTTP and the websocket servers.
+type RequestLimiter struct {
+	batchRequestLimit    int
+	batchResponseMaxSize int
+	allowedMethods       []string
+	deniedMethods        []string
+	ipLimiter            *rateLimiter
+	methodLimiters       []methodRateLimiter
+	// auth authenticates the requests of the namespaces requiring it, nil if disabled
+	auth *JWTAuth
+	// forwardToken authenticates the requests forwarded by the websocket server
+	forwardToken string
+}
+
+type methodRateLimiter struct {
+	method  string
+	limiter *rateLimiter
+}
+
+// NewRequestLimiter creates the request limiter of the JSON-RPC configuration, the JWT
+// authentication is optional.
+func NewRequestLimiter(cfg config.JSONRPCConfig, auth *JWTAuth) (*RequestLimiter, error) {
+	methodLimits, err := config.ParseMethodRateLimits(cfg.MethodRateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	l := &RequestLimiter{
+		batchRequestLimit:    cfg.BatchRequestLimit,
+		batchResponseMaxSize: cfg.BatchResponseMaxSize,
+		allowedMethods:       cfg.AllowedMethods,
+		deniedMethods:        cfg.DeniedMethods,
+		auth:                 auth,
+		forwardToken:         hex.EncodeToString(token),
+	}
+	if cfg.RateLimitPerIP > 0 {
+		l.ipLimiter = newRateLimiter(cfg.RateLimitPerIP, cfg.RateLimitBurst)
+	}
+	for _, limit := range methodLimits {
+		l.methodLimiters = append(l.methodLimiters, methodRateLimiter{
+			method:  limit.Method,
+			limiter: newRateLimiter(limit.Rate, limit.Burst),
+		})
+	}
+	return l, nil
+}
+
+// Handler wraps the HTTP handler of the JSON-RPC server with the limits.
+func (l *RequestLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				writeLimitError(w, nil, &limitError{
+					Code:       errCodeInvalidRequest,
+					Message:    fmt.Sprintf("request too large, max %d bytes", maxRequestContentLength),
+					httpStatus: http.StatusRequestEntityTooLarge,
+				})
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// the rate limits and the authentication of the forwarded requests are applied by the
+		// websocket server
+		forwarded := r.Header.Get(forwardedRequestHeader) == l.forwardToken
+		id, lerr := l.checkMessage(clientIP(r.RemoteAddr), body, forwarded, forwarded || l.isAuthenticated(r))
+		if lerr != nil {
+			writeLimitError(w, id, lerr)
+			return
+		}
+
+		// only the batch responses are buffered and limited, like geth, so the large responses
+		// of single requests (e.g. the debug traces) are streamed
+		if l.batchResponseMaxSize <= 0 || !isBatch(body) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rw := &limitedResponseWriter{ResponseWriter: w, limit: l.batchResponseMaxSize, status: http.StatusOK}
+		next.ServeHTTP(rw, r)
+		if rw.exceeded {
+			writeLimitError(w, id, &limitError{
+				Code:       errCodeResponseTooLarge,
+				Message:    fmt.Sprintf("response too large, max %d bytes", l.batchResponseMaxSize),
+				httpStatus: http.StatusOK,
+			})
+			return
+		}
+		w.WriteHeader(rw.status)
+		_, _ = w.Write(rw.buf.Bytes())
+	})
+}
+
+// isAuthenticated returns true if the authentication is disabled or the request has a valid token.
+func (l *RequestLimiter) isAuthenticated(r *http.Request) bool {
+	return l.auth == nil || l.auth.authenticate(r) == nil
+}
+
+// checkMessage checks the limits of the single or batch JSON-RPC request message of the client
+// and returns the id of the response. The messages which can't be parsed are left to the server.
+func (l *RequestLimiter) checkMessage(ip string, msg []byte, skipRateLimits, authenticated bool) (json.RawMessage, *limitError) {
+	var reqs []rpcRequest
+	var id json.RawMessage
+	if isBatch(msg) {
+		if err := json.Unmarshal(msg, &reqs); err != nil {
+			return nil, nil
+		}
+		if l.batchRequestLimit > 0 && len(reqs) > l.batchRequestLimit {
+			return nil, &limitError{
+				Code:       errCodeInvalidRequest,
+				Message:    fmt.Sprintf("batch too large, max %d requests", l.batchRequestLimit),
+				httpStatus: http.StatusOK,
+			}
+		}
+	} else {
+		var req rpcRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			return nil, nil
+		}
+		reqs = []rpcRequest{req}
+		id = req.ID
+	}
+
+	for _, req := range reqs {
+		if !l.isMethodAllowed(req.Method) {
+			return id, &limitError{
+				Code:       errCodeMethodNotFound,
+				Message:    fmt.Sprintf("the method %s does not exist/is not available", req.Method),
+				httpStatus: http.StatusOK,
+			}
+		}
+		if !authenticated && l.auth != nil && l.auth.requiresAuth(req.Method) {
+			return id, &limitError{
+				Code:       errCodeUnauthorized,
+				Message:    fmt.Sprintf("the method %s requires authentication", req.Method),
+				httpStatus: http.StatusUnauthorized,
+			}
+		}
+	}
+
+	if skipRateLimits || l.allowRequests(ip, reqs) {
+		return id, nil
+	}
+	return id, &limitError{
+		Code:       errCodeRateLimitExceeded,
+		Message:    "rate limit exceeded",
+		httpStatus: http.StatusTooManyRequests,
+	}
+}
+
+// isMethodAllowed returns false if the method is denied or not in the allowed methods.
+func (l *RequestLimiter) isMethodAllowed(method string) bool {
+	for _, pattern := range l.deniedMethods {
+		if matchMethod(pattern, method) {
+			return false
+		}
+	}
+	if len(l.allowedMethods) == 0 {
+		return true
+	}
+	for _, pattern := range l.allowedMethods {
+		if matchMethod(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowRequests consumes the rate limit tokens of the requests of the client, the requests of a
+// batch count individually. All the method rate limits matching a method apply.
+func (l *RequestLimiter) allowRequests(ip string, reqs []rpcRequest) bool {
+	now := time.Now()
+	if l.ipLimiter != nil && !l.ipLimiter.allowN(ip, len(reqs), now) {
+		return false
+	}
+	for _, ml := range l.methodLimiters {
+		var n int
+		for _, req := range reqs {
+			if matchMethod(ml.method, req.Method) {
+				n++
+			}
+		}
+		if n > 0 && !ml.limiter.allowN(ip, n, now) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchMethod returns true if the method matches the method name or the `namespace_*` pattern.
+func matchMethod(pattern, method string) bool {
+	if strings.HasSuffix(pattern, "_*") {
+		return strings.HasPrefix(method, pattern[:len(pattern)-1])
+	}
+	return pattern == method
+}
+
+// clientIP returns the IP of the remote address of the request.
+func clientIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+func newLimitErrorResponse(id json.RawMessage, lerr *limitError) *limitErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &limitErrorResponse{Jsonrpc: "2.0", ID: id, Error: lerr}
+}
+
+func writeLimitError(w http.ResponseWriter, id json.RawMessage, lerr *limitError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(lerr.httpStatus)
+	_ = json.NewEncoder(w).Encode(newLimitErrorResponse(id, lerr))
+}
+
+// limitedResponseWriter buffers the response until the size limit is exceeded.
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	buf      bytes.Buffer
+	limit    int
+	status   int
+	exceeded bool
+}
+
+func (w *limitedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *limitedResponseWriter) Write(b []byte) (int, error) {
+	if w.exceeded {
+		return len(b), nil
+	}
+	if w.buf.Len()+len(b) > w.limit {
+		w.exceeded = true
+		w.buf.Reset()
+		return len(b), nil
+	}
+	return w.buf.Write(b)
+}
+
+// rateLimiter is a token bucket rate limiter per key.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu          sync.Mutex
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allowN consumes n tokens of the bucket of the key, returns false without consuming them if the
+// bucket doesn't have enough tokens.
+func (rl *rateLimiter) allowN(key string, n int, now time.Time) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if now.Sub(rl.lastCleanup) >= rateLimiterCleanupInterval {
+		rl.cleanup(now)
+		rl.lastCleanup = now
+	}
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: rl.burst, last: now}
+		rl.buckets[key] = b
+	}
+	b.tokens = math.Min(rl.burst, b.tokens+now.Sub(b.last).Seconds()*rl.rate)
+	b.last = now
+
+	if b.tokens < float64(n) {
+		return false
+	}
+	b.tokens -= float64(n)
+	return true
+}
+
+// cleanup removes the buckets refilled completely, they're equivalent to new buckets.
+func (rl *rateLimiter) cleanup(now time.Time) {
+	for key, b := range rl.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*rl.rate >= rl.burst {
+			delete(rl.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/servprotocolorg/serv/v12/server/config"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	response := `{"jsonrpc":"2.0","id":1,"result":"0x1"}`
+	jwtSecret := make([]byte, jwtSecretLength)
+	server := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	})
+
+	newHandler := func(malleate func(cfg *config.JSONRPCConfig)) (*RequestLimiter, http.Handler) {
+		cfg := config.DefaultJSONRPCConfig()
+		malleate(cfg)
+		var auth *JWTAuth
+		if len(cfg.AuthAPI) > 0 {
+			auth = NewJWTAuth(jwtSecret, cfg.AuthAPI)
+		}
+		limiter, err := NewRequestLimiter(*cfg, auth)
+		require.NoError(t, err)
+		return limiter, limiter.Handler(server)
+	}
+
+	serve := func(handler http.Handler, body string, header http.Header) (*httptest.ResponseRecorder, *limitErrorResponse) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		for key := range header {
+			req.Header.Set(key, header.Get(key))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Body.String() == response {
+			return rec, nil
+		}
+		var res limitErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return rec, &res
+	}
+
+	t.Run("batch request limit", func(t *testing.T) {
+		_, handler := newHandler(func(cfg *config.JSONRPCConfig) { cfg.BatchRequestLimit = 2 })
+
+		_, res := serve(handler, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"}]`, nil)
+		require.Nil(t, res)
+		_, res = serve(handler, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"}]`, nil)
+		require.NotNil(t, res)
+		require.Equal(t, errCodeInvalidRequest, res.Error.Code)
+	})
+
+	t.Run("batch response max size", func(t *testing.T) {
+		_, handler := newHandler(func(cfg *config.JSONRPCConfig) { cfg.BatchResponseMaxSize = len(response) - 1 })
+
+		_, res := serve(handler, `[{"id":7,"method":"eth_chainId"}]`, nil)
+		require.NotNil(t, res)
+		require.Equal(t, errCodeResponseTooLarge, res.Error.Code)
+
+		// the responses of single requests aren't limited
+		_, res = serve(handler, `{"id":7,"method":"eth_chainId"}`, nil)
+		require.Nil(t, res)
+	})
+
+	t.Run("max request size", func(t *testing.T) {
+		_, handler := newHandler(func(*config.JSONRPCConfig) {})
+
+		param := strings.Repeat("0", maxRequestContentLength)
+		rec, res := serve(handler, `{"id":1,"method":"eth_call","params":["`+param+`"]}`, nil)
+		require.NotNil(t, res)
+		require.Equal(t, errCodeInvalidRequest, res.Error.Code)
+		require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+		_, res = serve(handler, `{"id":1,"method":"eth_call","params":["`+param[:1024]+`"]}`, nil)
+		require.Nil(t, res)
+	})
+
+	t.Run("allowed and denied methods", func(t *testing.T) {
+		_, handler := newHandler(func(cfg *config.JSONRPCConfig) {
+			cfg.AllowedMethods = []string{"eth_*", "net_version"}
+			cfg.DeniedMethods = []string{"eth_getLogs"}
+		})
+
+		for method, allowed := range map[string]bool{
+			"eth_chainId":         true,
+			"net_version":         true,
+			"eth_getLogs":         false,
+			"debug_traceCall":     false,
+			"ethx_chainId":        false,
+			"net_peerCount":       false,
+			"web3_clientVersion":  false,
+			"eth_getBlockByHash":  true,
+			"eth_getBlockReceipt": true,
+		} {
+			_, res := serve(handler, `{"id":1,"method":"`+method+`"}`, nil)
+			if allowed {
+				require.Nil(t, res, method)
+			} else {
+				require.NotNil(t, res, method)
+				require.Equal(t, errCodeMethodNotFound, res.Error.Code, method)
+			}
+		}
+
+		// a denied method rejects the batch
+		_, res := serve(handler, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_getLogs"}]`, nil)
+		require.NotNil(t, res)
+	})
+
+	t.Run("rate limits", func(t *testing.T) {
+		limiter, handler := newHandler(func(cfg *config.JSONRPCConfig) {
+			cfg.RateLimitPerIP = 0.001
+			cfg.RateLimitBurst = 4
+			cfg.MethodRateLimits = []string{"eth_getLogs=0.001:1"}
+		})
+
+		_, res := serve(handler, `{"id":1,"method":"eth_getLogs"}`, nil)
+		require.Nil(t, res)
+		rec, res := serve(handler, `{"id":1,"method":"eth_getLogs"}`, nil)
+		require.NotNil(t, res)
+		require.Equal(t, errCodeRateLimitExceeded, res.Error.Code)
+		require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+		// the requests of a batch count individually
+		_, res = serve(handler, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"}]`, nil)
+		require.Nil(t, res)
+		_, res = serve(handler, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"}]`, nil)
+		require.NotNil(t, res)
+
+		// the forwarded websocket requests are already rate limited
+		_, res = serve(handler, `{"id":1,"method":"eth_chainId"}`, http.Header{forwardedRequestHeader: {limiter.forwardToken}})
+		require.Nil(t, res)
+		_, res = serve(handler, `{"id":1,"method":"eth_chainId"}`, http.Header{forwardedRequestHeader: {"invalid"}})
+		require.NotNil(t, res)
+	})
+
+	t.Run("authenticated namespaces", func(t *testing.T) {
+		limiter, handler := newHandler(func(cfg *config.JSONRPCConfig) { cfg.AuthAPI = []string{"admin"} })
+		token := newJWTToken(jwtSecret, "HS256", fmt.Sprintf(`{"iat":%d}`, time.Now().Unix()))
+
+		_, res := serve(handler, `{"id":1,"method":"eth_chainId"}`, nil)
+		require.Nil(t, res)
+		rec, res := serve(handler, `{"id":1,"method":"admin_peers"}`, nil)
+		require.NotNil(t, res)
+		require.Equal(t, errCodeUnauthorized, res.Error.Code)
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		_, res = serve(handler, `{"id":1,"method":"admin_peers"}`, http.Header{"Authorization": {"Bearer invalid"}})
+		require.NotNil(t, res)
+
+		_, res = serve(handler, `{"id":1,"method":"admin_peers"}`, http.Header{"Authorization": {"Bearer " + token}})
+		require.Nil(t, res)
+		// the forwarded websocket requests are already authenticated
+		_, res = serve(handler, `{"id":1,"method":"admin_peers"}`, http.Header{forwardedRequestHeader: {limiter.forwardToken}})
+		require.Nil(t, res)
+	})
+}
@@ -0,0 +1,198 @@
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/rs/zerolog"
+
+	"github.com/servprotocolorg/serv/v12/server/config"
+)
+
+// Namespace is the JSON-RPC namespace of the node administration API.
+const Namespace = config.AdminAPINamespace
+
+// Switch is the p2p switch of the node, the peers are removed from it.
+type Switch interface {
+	Peers() p2p.IPeerSet
+	StopPeerGracefully(peer p2p.Peer)
+}
+
+// WebsocketsServer is the JSON-RPC websocket server of the node.
+type WebsocketsServer interface {
+	Start() error
+	Stop() error
+	IsRunning() bool
+}
+
+// peerDialer dials the peers, it's implemented by the local CometBFT client of the node.
+type peerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// PeerInfo is the information of a connected peer.
+type PeerInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ListenAddr string `json:"listenAddr"`
+	RemoteIP   string `json:"remoteIP"`
+	Inbound    bool   `json:"inbound"`
+	Network    string `json:"network"`
+	Version    string `json:"version"`
+}
+
+// NodeInfo is the information of the node.
+type NodeInfo struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	ListenAddr        string `json:"listenAddr"`
+	Network           string `json:"network"`
+	Version           string `json:"version"`
+	LatestBlockHeight int64  `json:"latestBlockHeight"`
+	CatchingUp        bool   `json:"catchingUp"`
+}
+
+// API is the admin prefixed set of APIs to administrate the node, it's only exposed to the
+// authenticated clients as the configuration requires the JWT authentication of the namespace.
+type API struct {
+	logger   log.Logger
+	tmClient rpcclient.Client
+	sw       Switch
+	wsServer WebsocketsServer
+}
+
+// NewAPI creates an instance of the admin API. The switch is nil if the CometBFT node doesn't
+// run in process.
+func NewAPI(logger log.Logger, clientCtx client.Context, sw Switch, wsServer WebsocketsServer) *API {
+	return &API{
+		logger:   logger.With("api", "admin"),
+		tmClient: clientCtx.Client.(rpcclient.Client),
+		sw:       sw,
+		wsServer: wsServer,
+	}
+}
+
+// Peers returns the connected peers of the node.
+func (api *API) Peers() ([]PeerInfo, error) {
+	api.logger.Debug("admin_peers")
+	netInfo, err := api.tmClient.NetInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, PeerInfo{
+			ID:         string(peer.NodeInfo.ID()),
+			Name:       peer.NodeInfo.Moniker,
+			ListenAddr: peer.NodeInfo.ListenAddr,
+			RemoteIP:   peer.RemoteIP,
+			Inbound:    !peer.IsOutbound,
+			Network:    peer.NodeInfo.Network,
+			Version:    peer.NodeInfo.Version,
+		})
+	}
+	return peers, nil
+}
+
+// NodeInfo returns the information of the node.
+func (api *API) NodeInfo() (*NodeInfo, error) {
+	api.logger.Debug("admin_nodeInfo")
+	status, err := api.tmClient.Status(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeInfo{
+		ID:                string(status.NodeInfo.ID()),
+		Name:              status.NodeInfo.Moniker,
+		ListenAddr:        status.NodeInfo.ListenAddr,
+		Network:           status.NodeInfo.Network,
+		Version:           status.NodeInfo.Version,
+		LatestBlockHeight: status.SyncInfo.LatestBlockHeight,
+		CatchingUp:        status.SyncInfo.CatchingUp,
+	}, nil
+}
+
+// AddPeer dials the peer of the `id@host:port` address, the peer isn't persistent.
+func (api *API) AddPeer(peer string) (bool, error) {
+	api.logger.Debug("admin_addPeer", "peer", peer)
+	dialer, ok := api.tmClient.(peerDialer)
+	if !ok {
+		return false, errors.New("adding peers requires the CometBFT node to run in process")
+	}
+
+	if _, err := p2p.NewNetAddressString(peer); err != nil {
+		return false, fmt.Errorf("invalid peer address %s: %w", peer, err)
+	}
+	if _, err := dialer.DialPeers(context.Background(), []string{peer}, false, false, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemovePeer disconnects the peer of the id or `id@host:port` address, returns false if the peer
+// isn't connected.
+func (api *API) RemovePeer(peer string) (bool, error) {
+	api.logger.Debug("admin_removePeer", "peer", peer)
+	if api.sw == nil {
+		return false, errors.New("removing peers requires the CometBFT node to run in process")
+	}
+
+	id, _, _ := strings.Cut(peer, "@")
+	if bz, err := hex.DecodeString(id); err != nil || len(bz) != p2p.IDByteLength {
+		return false, fmt.Errorf("invalid peer id %s", id)
+	}
+
+	p := api.sw.Peers().Get(p2p.ID(id))
+	if p == nil {
+		return false, nil
+	}
+	api.sw.StopPeerGracefully(p)
+	return true, nil
+}
+
+// SetLogLevel sets the minimum level of the logs of the node at runtime: trace, debug, info,
+// warn, error, fatal, panic or disabled. The logs below the log level of the node configuration
+// are never written, the node has to start with the lowest level to be able to lower it at
+// runtime.
+func (api *API) SetLogLevel(level string) (bool, error) {
+	api.logger.Debug("admin_setLogLevel", "level", level)
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return false, err
+	}
+	if lvl == zerolog.NoLevel {
+		return false, fmt.Errorf("invalid log level %s", level)
+	}
+
+	zerolog.SetGlobalLevel(lvl)
+	api.logger.Info("log level set", "level", lvl.String())
+	return true, nil
+}
+
+// StartWS starts the JSON-RPC websocket server on the address of the node configuration.
+func (api *API) StartWS() (bool, error) {
+	api.logger.Debug("admin_startWS")
+	if err := api.wsServer.Start(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// StopWS stops the JSON-RPC websocket server and closes its connections.
+func (api *API) StopWS() (bool, error) {
+	api.logger.Debug("admin_stopWS")
+	if err := api.wsServer.Stop(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
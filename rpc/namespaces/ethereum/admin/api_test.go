@@ -0,0 +1,185 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
+)
+
+// mockSwitch is the p2p switch of a node with the peers of the set.
+type mockSwitch struct {
+	peers   *p2p.PeerSet
+	stopped []p2p.ID
+}
+
+func (sw *mockSwitch) Peers() p2p.IPeerSet { return sw.peers }
+
+func (sw *mockSwitch) StopPeerGracefully(peer p2p.Peer) {
+	sw.stopped = append(sw.stopped, peer.ID())
+	sw.peers.Remove(peer)
+}
+
+// mockLocalClient is the local CometBFT client of a node running in process, it dials the peers.
+type mockLocalClient struct {
+	*mocks.Client
+}
+
+func (c mockLocalClient) DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error) {
+	args := c.Called(ctx, peers, persistent, unconditional, private)
+	if err := args.Error(1); err != nil {
+		return nil, err
+	}
+	return args.Get(0).(*coretypes.ResultDialPeers), nil
+}
+
+// mockWebsocketsServer is a websocket server failing to start or stop with the given errors.
+type mockWebsocketsServer struct {
+	running  bool
+	startErr error
+	stopErr  error
+}
+
+func (s *mockWebsocketsServer) Start() error {
+	if s.startErr != nil {
+		return s.startErr
+	}
+	s.running = true
+	return nil
+}
+
+func (s *mockWebsocketsServer) Stop() error {
+	if s.stopErr != nil {
+		return s.stopErr
+	}
+	s.running = false
+	return nil
+}
+
+func (s *mockWebsocketsServer) IsRunning() bool { return s.running }
+
+func newClientCtx(c client.TendermintRPC) client.Context {
+	return client.Context{}.WithClient(c)
+}
+
+func TestRemovePeer(t *testing.T) {
+	peer := p2pmock.NewPeer(nil)
+	peers := p2p.NewPeerSet()
+	require.NoError(t, peers.Add(peer))
+	sw := &mockSwitch{peers: peers}
+	api := NewAPI(log.NewNopLogger(), newClientCtx(mocks.NewClient(t)), sw, nil)
+
+	testCases := []struct {
+		name       string
+		peer       string
+		expRemoved bool
+		expError   bool
+	}{
+		{"invalid peer id", "node", false, true},
+		{"peer not connected", "0123456789012345678901234567890123456789", false, false},
+		{"peer id", string(peer.ID()), true, false},
+		{"peer removed already", string(peer.ID()) + "@127.0.0.1:26656", false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			removed, err := api.RemovePeer(tc.peer)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRemoved, removed)
+		})
+	}
+	require.Equal(t, []p2p.ID{peer.ID()}, sw.stopped)
+
+	t.Run("node out of process", func(t *testing.T) {
+		api := NewAPI(log.NewNopLogger(), newClientCtx(mocks.NewClient(t)), nil, nil)
+		_, err := api.RemovePeer(string(peer.ID()))
+		require.Error(t, err)
+	})
+}
+
+func TestAddPeer(t *testing.T) {
+	address := "0123456789012345678901234567890123456789@127.0.0.1:26656"
+
+	t.Run("node in process", func(t *testing.T) {
+		c := mockLocalClient{mocks.NewClient(t)}
+		c.On("DialPeers", mock.Anything, []string{address}, false, false, false).
+			Return(&coretypes.ResultDialPeers{}, nil).Once()
+		c.On("DialPeers", mock.Anything, []string{"1123456789012345678901234567890123456789@127.0.0.1:26656"}, false, false, false).
+			Return(nil, errors.New("dial failed")).Once()
+		api := NewAPI(log.NewNopLogger(), newClientCtx(c), nil, nil)
+
+		added, err := api.AddPeer(address)
+		require.NoError(t, err)
+		require.True(t, added)
+
+		_, err = api.AddPeer("1123456789012345678901234567890123456789@127.0.0.1:26656")
+		require.Error(t, err)
+
+		_, err = api.AddPeer("127.0.0.1:26656")
+		require.Error(t, err)
+		c.AssertNumberOfCalls(t, "DialPeers", 2)
+	})
+
+	t.Run("node out of process", func(t *testing.T) {
+		api := NewAPI(log.NewNopLogger(), newClientCtx(mocks.NewClient(t)), nil, nil)
+		_, err := api.AddPeer(address)
+		require.Error(t, err)
+	})
+}
+
+func TestSetLogLevel(t *testing.T) {
+	level := zerolog.GlobalLevel()
+	t.Cleanup(func() { zerolog.SetGlobalLevel(level) })
+	api := NewAPI(log.NewNopLogger(), newClientCtx(mocks.NewClient(t)), nil, nil)
+
+	for _, lvl := range []string{"trace", "debug", "info", "warn", "error", "disabled"} {
+		ok, err := api.SetLogLevel(lvl)
+		require.NoError(t, err, lvl)
+		require.True(t, ok)
+		require.Equal(t, lvl, zerolog.GlobalLevel().String())
+	}
+
+	for _, lvl := range []string{"", "verbose"} {
+		_, err := api.SetLogLevel(lvl)
+		require.Error(t, err, lvl)
+	}
+	require.Equal(t, zerolog.Disabled, zerolog.GlobalLevel())
+}
+
+func TestStartStopWS(t *testing.T) {
+	wsServer := &mockWebsocketsServer{}
+	api := NewAPI(log.NewNopLogger(), newClientCtx(mocks.NewClient(t)), nil, wsServer)
+
+	ok, err := api.StartWS()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, wsServer.IsRunning())
+
+	ok, err = api.StopWS()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, wsServer.IsRunning())
+
+	wsServer.startErr = errors.New("already started")
+	ok, err = api.StartWS()
+	require.Error(t, err)
+	require.False(t, ok)
+
+	wsServer.stopErr = errors.New("already stopped")
+	ok, err = api.StopWS()
+	require.Error(t, err)
+	require.False(t, ok)
+}
@@ -184,6 +184,7 @@ func (suite *EthRpcTestSuite) Test_GetBlockByNumberAndHash() {
 		suite.Require().NoError(err)
 		blockBloom, err := suite.CITS.RpcBackend.BlockBloom(resultBlockResult)
 		suite.Require().NoError(err, "failed to fetch block bloom")
+		blockRandom := rpctypes.BlockRandomFromEvents(resultBlockResult.EndBlockEvents)
 
 		baseFee := suite.App().FeeMarketKeeper().GetParams(suite.Ctx()).BaseFee
 		consensusParams, err := suite.CITS.QueryClients.ClientQueryCtx.Client.(tmrpcclient.NetworkClient).ConsensusParams(context.Background(), ptrInt64(testBlockHeight))
@@ -335,7 +336,8 @@ func (suite *EthRpcTestSuite) Test_GetBlockByNumberAndHash() {
 		suite.Equal("0x"+hex.EncodeToString(blockResult.Block.Hash()), textResultStruct.Hash, "hash must be Tendermint block hash")
 		suite.Equal(fmt.Sprintf("0x%x", blockBloom.Bytes()), textResultStruct.LogsBloom)
 		suite.Equal(strings.ToLower(suite.CITS.ValidatorAccounts.Number(1).GetEthAddress().String()), textResultStruct.Miner, "mis-match validator address as miner or must be lower-case") // Tendermint node uses the first pre-defined validator
-		suite.Equal("0x0000000000000000000000000000000000000000000000000000000000000000", textResultStruct.MixHash, "mixHash must be zero since PoS chain does not have this")
+		suite.Equal(blockRandom.Hex(), textResultStruct.MixHash, "mixHash must be the block randomness")
+		suite.NotEqual(common.Hash{}.Hex(), textResultStruct.MixHash, "mixHash must not be zero after the first block")
 		suite.Equal("0x0000000000000000", textResultStruct.Nonce, "nonce must be zero since PoS chain does not have this")
 		suite.Equal(fmt.Sprintf("0x%x", testBlockHeight), textResultStruct.Number)
 		suite.Equal("0x"+hex.EncodeToString(previousBlockResult.Block.Hash()), textResultStruct.ParentHash, "parentHash must be previous Tendermint block hash")
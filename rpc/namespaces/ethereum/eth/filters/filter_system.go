@@ -219,6 +219,21 @@ func (es EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFu
 	return es.subscribe(sub)
 }
 
+// SubscribeEthTxs subscribes to the events of the committed txs of the evm module. The
+// subscription shares the topic of the logs subscriptions.
+func (es EventSystem) SubscribeEthTxs() (*Subscription, pubsub.UnsubscribeFunc, error) {
+	sub := &Subscription{
+		id:        rpc.NewID(),
+		typ:       filters.LogsSubscription,
+		event:     evmEvents,
+		created:   time.Now().UTC(),
+		logs:      make(chan []*ethtypes.Log),
+		installed: make(chan struct{}, 1),
+		err:       make(chan error, 1),
+	}
+	return es.subscribe(sub)
+}
+
 type filterIndex map[filters.Type]map[rpc.ID]*Subscription
 
 // eventLoop (un)installs filters and processes mux events.
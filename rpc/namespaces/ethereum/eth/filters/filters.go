@@ -154,6 +154,19 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// query the log index of the indexer if it covers the range, the blocks above the head have no logs
+	indexedTo := to
+	if indexedTo > head {
+		indexedTo = head
+	}
+	indexedLogs, ok, err := f.backend.GetLogsFromIndex(from, indexedTo, f.criteria.Addresses, f.criteria.Topics, logLimit)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return indexedLogs, nil
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
@@ -0,0 +1,117 @@
+package trace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/servprotocolorg/serv/v12/rpc"
+	"github.com/servprotocolorg/serv/v12/rpc/backend"
+	rpctypes "github.com/servprotocolorg/serv/v12/rpc/types"
+	"github.com/servprotocolorg/serv/v12/types"
+)
+
+// Namespace is the JSON-RPC namespace of the Parity style trace API.
+const Namespace = "trace"
+
+func init() {
+	if err := rpc.RegisterAPINamespace(Namespace, newAPIs); err != nil {
+		panic(err)
+	}
+}
+
+func newAPIs(ctx *server.Context,
+	clientCtx client.Context,
+	_ *rpcclient.WSClient,
+	allowUnprotectedTxs bool,
+	indexer types.EVMTxIndexer,
+) []ethrpc.API {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	return []ethrpc.API{
+		{
+			Namespace: Namespace,
+			Version:   "1.0",
+			Service:   NewAPI(ctx, evmBackend),
+			Public:    true,
+		},
+	}
+}
+
+// API is the collection of Parity (OpenEthereum) style tracing APIs, the call traces are
+// computed by the flat call tracer.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Parity style tracing methods.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the call traces of all the transactions of the given block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*rpctypes.FlatCallTrace, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	blockTraces, err := a.backend.TraceBlockCalls(blockNr)
+	if err != nil || blockTraces == nil {
+		return nil, err
+	}
+
+	traces := []*rpctypes.FlatCallTrace{}
+	for _, txTraces := range blockTraces {
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// Transaction returns the call traces of the given transaction.
+func (a *API) Transaction(hash common.Hash) ([]*rpctypes.FlatCallTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	return a.backend.TraceTransactionCalls(hash)
+}
+
+// Filter returns the call traces of the given block range matching the filter.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatCallTrace, error) {
+	a.logger.Debug("trace_filter", "from", args.FromBlock, "to", args.ToBlock)
+	return a.backend.TraceFilter(args)
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and returns their
+// traces. Only the trace type is supported.
+func (a *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	if len(traceTypes) == 0 {
+		return nil, errors.New("no trace type requested")
+	}
+	for _, traceType := range traceTypes {
+		if traceType != rpctypes.TraceTypeTrace {
+			return nil, fmt.Errorf("unsupported trace type: %s", traceType)
+		}
+	}
+
+	blockTraces, err := a.backend.TraceBlockCalls(blockNr)
+	if err != nil || blockTraces == nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.TraceResults, len(blockTraces))
+	for i, txTraces := range blockTraces {
+		results[i] = rpctypes.NewTraceResults(txTraces)
+	}
+	return results, nil
+}
@@ -1,51 +1,134 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/servprotocolorg/serv/v12/rpc/backend"
 	"github.com/servprotocolorg/serv/v12/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The content of the pool is read from the CometBFT mempool, only Ethereum transactions are reported.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = formatByNonce(txs)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender.Hex()] = formatByNonce(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool for the given address
+func (api *PublicAPI) ContentFrom(addr common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", addr.Hex())
+	pending, queued, err := api.backend.TxPoolContentFrom(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatByNonce(pending[addr]),
+		"queued":  formatByNonce(queued[addr]),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = inspectByNonce(txs)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender.Hex()] = inspectByNonce(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// formatByNonce keys the transactions of a single sender by their decimal nonce.
+func formatByNonce(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return result
+}
+
+// inspectByNonce summarizes the transactions of a single sender, keyed by their decimal nonce.
+func inspectByNonce(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = inspectTx(tx)
+	}
+	return result
+}
+
+// inspectTx formats a transaction the same way geth does for `txpool_inspect`.
+func inspectTx(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
+
+// countTxs returns the total number of transactions of all senders.
+func countTxs(txs types.TxPoolTransactions) int {
+	count := 0
+	for _, senderTxs := range txs {
+		count += len(senderTxs)
 	}
+	return count
 }
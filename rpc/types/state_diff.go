@@ -0,0 +1,136 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// StateDiffTracer is the tracer, and its configuration, used to compute the state diff of
+// the transactions.
+const (
+	StateDiffTracer       = evmtypes.TracerPrestate
+	StateDiffTracerConfig = `{"diffMode":true}`
+)
+
+// StateDiff is the result of the prestate tracer in diff mode: the touched accounts before
+// and after a transaction, limited to the modified fields and storage slots.
+type StateDiff struct {
+	Pre  map[common.Address]*StateDiffAccount `json:"pre"`
+	Post map[common.Address]*StateDiffAccount `json:"post"`
+}
+
+// StateDiffAccount is the state of an account in a state diff, the unmodified fields are omitted.
+type StateDiffAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   *uint64                     `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// stateDiffEntry is the RLP encoding of the state of a touched account before and after a
+// transaction.
+type stateDiffEntry struct {
+	Address common.Address
+	Pre     stateDiffAccountRLP
+	Post    stateDiffAccountRLP
+}
+
+// The presence flags of the state of an account and of its fields in the state diff commitment,
+// so that an omitted account or field isn't encoded like its zero value.
+const (
+	stateDiffAccountPresent uint8 = 1 << iota
+	stateDiffBalancePresent
+	stateDiffNoncePresent
+	stateDiffCodePresent
+	stateDiffStoragePresent
+)
+
+type stateDiffAccountRLP struct {
+	Flags   uint8
+	Balance *big.Int
+	Nonce   uint64
+	Code    []byte
+	Storage []stateDiffSlot
+}
+
+type stateDiffSlot struct {
+	Key   common.Hash
+	Value common.Hash
+}
+
+func newStateDiffAccountRLP(account *StateDiffAccount) stateDiffAccountRLP {
+	if account == nil {
+		return stateDiffAccountRLP{Balance: new(big.Int)}
+	}
+
+	res := stateDiffAccountRLP{
+		Flags:   stateDiffAccountPresent,
+		Balance: new(big.Int),
+		Code:    account.Code,
+		Storage: make([]stateDiffSlot, 0, len(account.Storage)),
+	}
+	if account.Balance != nil {
+		res.Flags |= stateDiffBalancePresent
+		res.Balance = account.Balance.ToInt()
+	}
+	if account.Nonce != nil {
+		res.Flags |= stateDiffNoncePresent
+		res.Nonce = *account.Nonce
+	}
+	if account.Code != nil {
+		res.Flags |= stateDiffCodePresent
+	}
+	if account.Storage != nil {
+		res.Flags |= stateDiffStoragePresent
+	}
+	for key, value := range account.Storage {
+		res.Storage = append(res.Storage, stateDiffSlot{Key: key, Value: value})
+	}
+	sort.Slice(res.Storage, func(i, j int) bool {
+		return bytes.Compare(res.Storage[i].Key.Bytes(), res.Storage[j].Key.Bytes()) < 0
+	})
+	return res
+}
+
+// Hash returns the commitment of the state diff: the keccak256 hash of the RLP encoding of
+// the touched accounts, sorted by address, with their state before and after the transaction
+// and their storage slots sorted by key. The state of an account starts with the flags of its
+// present fields, the omitted fields are encoded as zero values. It's the substitute of the intermediate state roots,
+// which aren't computed per transaction by the IAVL store.
+func (d StateDiff) Hash() (common.Hash, error) {
+	addresses := make([]common.Address, 0, len(d.Pre)+len(d.Post))
+	for addr := range d.Pre {
+		addresses = append(addresses, addr)
+	}
+	for addr := range d.Post {
+		if _, ok := d.Pre[addr]; !ok {
+			addresses = append(addresses, addr)
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	entries := make([]stateDiffEntry, len(addresses))
+	for i, addr := range addresses {
+		entries[i] = stateDiffEntry{
+			Address: addr,
+			Pre:     newStateDiffAccountRLP(d.Pre[addr]),
+			Post:    newStateDiffAccountRLP(d.Post[addr]),
+		}
+	}
+
+	bz, err := rlp.EncodeToBytes(entries)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(bz), nil
+}
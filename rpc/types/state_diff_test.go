@@ -0,0 +1,78 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateDiffHash(t *testing.T) {
+	// prestate tracer output in diff mode
+	bz := []byte(`{
+		"post": {
+			"0x0000000000000000000000000000000000000001": {"balance": "0x1", "nonce": 2},
+			"0x0000000000000000000000000000000000000002": {"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000003",
+				"0x0000000000000000000000000000000000000000000000000000000000000002": "0x0000000000000000000000000000000000000000000000000000000000000004"
+			}}
+		},
+		"pre": {
+			"0x0000000000000000000000000000000000000001": {"balance": "0x5", "nonce": 1, "code": "0x"},
+			"0x0000000000000000000000000000000000000002": {"balance": "0x0", "code": "0x6000", "storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000001"
+			}}
+		}
+	}`)
+
+	var diff StateDiff
+	require.NoError(t, json.Unmarshal(bz, &diff))
+	hash, err := diff.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, common.Hash{}, hash)
+
+	// the commitment doesn't depend on the map iteration order
+	for i := 0; i < 10; i++ {
+		var other StateDiff
+		require.NoError(t, json.Unmarshal(bz, &other))
+		otherHash, err := other.Hash()
+		require.NoError(t, err)
+		require.Equal(t, hash, otherHash)
+	}
+
+	// the commitment depends on the modified slots
+	slot := common.BigToHash(common.Big2)
+	diff.Post[common.BigToAddress(common.Big2)].Storage[slot] = common.BigToHash(common.Big3)
+	modifiedHash, err := diff.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, modifiedHash)
+
+	// the omitted fields and accounts aren't committed like their zero values
+	addr := common.BigToAddress(common.Big1)
+	for name, accounts := range map[string][2]string{
+		"balance": {`{"nonce": 1}`, `{"balance": "0x0", "nonce": 1}`},
+		"nonce":   {`{"balance": "0x1"}`, `{"balance": "0x1", "nonce": 0}`},
+		"code":    {`{"balance": "0x1"}`, `{"balance": "0x1", "code": "0x"}`},
+		"storage": {`{"balance": "0x1"}`, `{"balance": "0x1", "storage": {}}`},
+		"account": {`null`, `{}`},
+	} {
+		var omitted, zero StateDiffAccount
+		require.NoError(t, json.Unmarshal([]byte(accounts[0]), &omitted), name)
+		require.NoError(t, json.Unmarshal([]byte(accounts[1]), &zero), name)
+		omittedDiff := StateDiff{Pre: map[common.Address]*StateDiffAccount{addr: &omitted}}
+		if accounts[0] == `null` {
+			omittedDiff.Pre[addr] = nil
+		}
+		omittedHash, err := omittedDiff.Hash()
+		require.NoError(t, err, name)
+		zeroHash, err := StateDiff{Pre: map[common.Address]*StateDiffAccount{addr: &zero}}.Hash()
+		require.NoError(t, err, name)
+		require.NotEqual(t, omittedHash, zeroHash, name)
+	}
+
+	// the commitment of an empty diff is well defined
+	emptyHash, err := StateDiff{}.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, emptyHash)
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// CallTracer is the tracer used to compute the Parity style call traces of the transactions.
+const CallTracer = evmtypes.TracerFlatCall
+
+// TraceTypeTrace is the only trace type supported by trace_replayBlockTransactions, the
+// stateDiff and vmTrace types of Parity are not supported.
+const TraceTypeTrace = "trace"
+
+// FlatCallTrace is a Parity style trace of a call frame, as returned by the flat call tracer.
+type FlatCallTrace struct {
+	Action              FlatCallAction  `json:"action"`
+	BlockHash           *common.Hash    `json:"blockHash,omitempty"`
+	BlockNumber         *uint64         `json:"blockNumber,omitempty"`
+	Error               string          `json:"error,omitempty"`
+	Result              *FlatCallResult `json:"result,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *common.Hash    `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64         `json:"transactionPosition,omitempty"`
+	Type                string          `json:"type"`
+}
+
+// FlatCallAction is the action of a call trace, its fields depend on the trace type: call,
+// create or suicide.
+type FlatCallAction struct {
+	Address       *common.Address `json:"address,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+}
+
+// FlatCallResult is the result of a call trace, it's omitted for the failed calls that
+// didn't revert.
+type FlatCallResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// Sender returns the account initiating the traced action, which is the self destructed
+// contract for the suicide traces.
+func (t *FlatCallTrace) Sender() *common.Address {
+	if t.Action.From != nil {
+		return t.Action.From
+	}
+	return t.Action.Address
+}
+
+// Recipient returns the account receiving the traced action, which is the created contract
+// for the create traces and the refund address for the suicide traces.
+func (t *FlatCallTrace) Recipient() *common.Address {
+	switch {
+	case t.Action.To != nil:
+		return t.Action.To
+	case t.Action.RefundAddress != nil:
+		return t.Action.RefundAddress
+	case t.Result != nil:
+		return t.Result.Address
+	default:
+		return nil
+	}
+}
+
+// TraceFilterArgs are the arguments of trace_filter. The traces match if they're sent by one
+// of the FromAddress accounts and received by one of the ToAddress accounts, an empty list
+// matching any account. After and Count paginate the matching traces.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// Matches returns true if the trace matches the address filters.
+func (args *TraceFilterArgs) Matches(trace *FlatCallTrace) bool {
+	return matchAddress(args.FromAddress, trace.Sender()) && matchAddress(args.ToAddress, trace.Recipient())
+}
+
+func matchAddress(addresses []common.Address, addr *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// TraceResults is the result of replaying a transaction with trace_replayBlockTransactions.
+type TraceResults struct {
+	Output          hexutil.Bytes    `json:"output"`
+	StateDiff       interface{}      `json:"stateDiff"`
+	Trace           []*FlatCallTrace `json:"trace"`
+	VMTrace         interface{}      `json:"vmTrace"`
+	TransactionHash *common.Hash     `json:"transactionHash,omitempty"`
+}
+
+// NewTraceResults returns the replay result of a transaction from its call traces, the
+// traces are stripped of their block and transaction fields.
+func NewTraceResults(traces []*FlatCallTrace) *TraceResults {
+	res := &TraceResults{
+		Output: hexutil.Bytes{},
+		Trace:  make([]*FlatCallTrace, len(traces)),
+	}
+	for i, trace := range traces {
+		if i == 0 {
+			res.TransactionHash = trace.TransactionHash
+			if trace.Result != nil && trace.Result.Output != nil {
+				res.Output = *trace.Result.Output
+			} else if trace.Result != nil && trace.Result.Code != nil {
+				res.Output = *trace.Result.Code
+			}
+		}
+
+		stripped := *trace
+		stripped.BlockHash = nil
+		stripped.BlockNumber = nil
+		stripped.TransactionHash = nil
+		stripped.TransactionPosition = nil
+		res.Trace[i] = &stripped
+	}
+	return res
+}
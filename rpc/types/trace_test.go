@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceFilterArgsMatches(t *testing.T) {
+	// flat call tracer output of a contract creation calling the sender, which self destructs
+	bz := []byte(`[
+		{
+			"action": {"from": "0x0000000000000000000000000000000000000001", "gas": "0x5208", "init": "0x00", "value": "0x0"},
+			"result": {"address": "0x0000000000000000000000000000000000000002", "code": "0x", "gasUsed": "0x0"},
+			"subtraces": 1, "traceAddress": [], "type": "create"
+		},
+		{
+			"action": {"callType": "call", "from": "0x0000000000000000000000000000000000000002", "gas": "0x0", "input": "0x", "to": "0x0000000000000000000000000000000000000001", "value": "0x0"},
+			"result": {"gasUsed": "0x0", "output": "0x"},
+			"subtraces": 1, "traceAddress": [0], "type": "call"
+		},
+		{
+			"action": {"address": "0x0000000000000000000000000000000000000001", "balance": "0x0", "refundAddress": "0x0000000000000000000000000000000000000003"},
+			"subtraces": 0, "traceAddress": [0, 0], "type": "suicide"
+		}
+	]`)
+	var traces []*FlatCallTrace
+	require.NoError(t, json.Unmarshal(bz, &traces))
+	require.Len(t, traces, 3)
+
+	addr1 := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	addr2 := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	addr3 := common.HexToAddress("0x0000000000000000000000000000000000000003")
+
+	testCases := []struct {
+		name       string
+		args       TraceFilterArgs
+		expMatches []bool
+	}{
+		{"no address filter", TraceFilterArgs{}, []bool{true, true, true}},
+		{"from address", TraceFilterArgs{FromAddress: []common.Address{addr1}}, []bool{true, false, true}},
+		{"to created contract", TraceFilterArgs{ToAddress: []common.Address{addr2}}, []bool{true, false, false}},
+		{"to refund address", TraceFilterArgs{ToAddress: []common.Address{addr3}}, []bool{false, false, true}},
+		{"from and to address", TraceFilterArgs{FromAddress: []common.Address{addr2}, ToAddress: []common.Address{addr1}}, []bool{false, true, false}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i, trace := range traces {
+				require.Equal(t, tc.expMatches[i], tc.args.Matches(trace), "trace %d", i)
+			}
+		})
+	}
+}
+
+func TestNewTraceResults(t *testing.T) {
+	txHash := common.HexToHash("0x01")
+	blockNumber, position := uint64(1), uint64(0)
+	output := hexutil.Bytes{0x2a}
+	traces := []*FlatCallTrace{
+		{
+			BlockNumber:         &blockNumber,
+			Result:              &FlatCallResult{Output: &output},
+			TraceAddress:        []int{},
+			TransactionHash:     &txHash,
+			TransactionPosition: &position,
+			Type:                "call",
+		},
+	}
+
+	res := NewTraceResults(traces)
+	require.Equal(t, &txHash, res.TransactionHash)
+	require.Equal(t, output, res.Output)
+	require.Len(t, res.Trace, 1)
+	require.Nil(t, res.Trace[0].BlockNumber)
+	require.Nil(t, res.Trace[0].TransactionHash)
+	require.Nil(t, res.Trace[0].TransactionPosition)
+	// the traces of the block are left untouched
+	require.Equal(t, &txHash, traces[0].TransactionHash)
+
+	bz, err := json.Marshal(NewTraceResults(nil))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"output":"0x","stateDiff":null,"trace":[],"vmTrace":null}`, string(bz))
+}
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -20,6 +23,7 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	BalanceProof []string        `json:"balanceProof"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -82,20 +86,39 @@ type RPCReceipt struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is the set of header fields to override when executing a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config of debug_traceCall, it extends the trace config with the
+// state and block overrides applied when executing the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it's required since the embedded trace config
+// implements it as well.
+func (c *TraceCallConfig) UnmarshalJSON(bz []byte) error {
+	if err := json.Unmarshal(bz, &c.TraceConfig); err != nil {
+		return err
+	}
+	var overrides struct {
+		StateOverrides *StateOverride  `json:"stateOverrides"`
+		BlockOverrides *BlockOverrides `json:"blockOverrides"`
+	}
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return err
+	}
+	c.StateOverrides = overrides.StateOverrides
+	c.BlockOverrides = overrides.BlockOverrides
+	return nil
 }
 
 type FeeHistoryResult struct {
@@ -116,3 +139,14 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// TxPoolTransactions groups the unconfirmed transactions of the tx pool by sender and nonce.
+type TxPoolTransactions map[common.Address]map[uint64]*RPCTransaction
+
+// Add inserts the given transaction under its sender and nonce.
+func (txs TxPoolTransactions) Add(tx *RPCTransaction) {
+	if txs[tx.From] == nil {
+		txs[tx.From] = make(map[uint64]*RPCTransaction)
+	}
+	txs[tx.From][uint64(tx.Nonce)] = tx
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceCallConfigUnmarshalJSON(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	var config TraceCallConfig
+	err := json.Unmarshal([]byte(`{
+		"tracer": "callTracer",
+		"tracerConfig": {"onlyTopCall": true},
+		"stateOverrides": {"0x0000000000000000000000000000000000000001": {"balance": "0x10"}},
+		"blockOverrides": {"number": "0x64", "time": "0x1"}
+	}`), &config)
+	require.NoError(t, err)
+
+	require.Equal(t, "callTracer", config.Tracer)
+	require.JSONEq(t, `{"onlyTopCall": true}`, config.TracerJsonConfig)
+	require.NotNil(t, config.StateOverrides)
+	require.Contains(t, *config.StateOverrides, addr)
+	require.Equal(t, big.NewInt(16), (*(*config.StateOverrides)[addr].Balance).ToInt())
+	require.NotNil(t, config.BlockOverrides)
+	require.Equal(t, big.NewInt(100), config.BlockOverrides.Number.ToInt())
+	require.Equal(t, big.NewInt(1), config.BlockOverrides.Time.ToInt())
+
+	config = TraceCallConfig{}
+	require.NoError(t, json.Unmarshal([]byte(`{"timeout": "5s"}`), &config))
+	require.Equal(t, "5s", config.Timeout)
+	require.Nil(t, config.StateOverrides)
+	require.Nil(t, config.BlockOverrides)
+}
@@ -52,8 +52,8 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 }
 
 // EthHeaderFromTendermint is an util function that returns an Ethereum Header
-// from a tendermint Header.
-func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFee *big.Int) *ethtypes.Header {
+// from a tendermint Header. The mix hash is the randomness of the block.
+func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFee *big.Int, mixHash common.Hash) *ethtypes.Header {
 	txHash := ethtypes.EmptyRootHash
 	if len(header.DataHash) == 0 {
 		txHash = common.BytesToHash(header.DataHash)
@@ -74,7 +74,7 @@ func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFe
 		GasUsed:     0,
 		Time:        time,
 		Extra:       []byte{},
-		MixDigest:   common.Hash{},
+		MixDigest:   mixHash,
 		Nonce:       ethtypes.BlockNonce{},
 		BaseFee:     baseFee,
 	}
@@ -104,7 +104,7 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 }
 
 // FormatBlock creates an ethereum block from a tendermint header and ethereum-formatted
-// transactions.
+// transactions. The mix hash is the randomness of the block.
 func FormatBlock(
 	header tmtypes.Header,
 	chainID *big.Int,
@@ -113,6 +113,7 @@ func FormatBlock(
 	transactions ethtypes.Transactions, fullTx bool,
 	receipts ethtypes.Receipts,
 	bloom ethtypes.Bloom,
+	mixHash common.Hash,
 	validatorAddr common.Address,
 	logger log.Logger,
 ) map[string]interface{} {
@@ -166,7 +167,7 @@ func FormatBlock(
 		"logsBloom":        bloom,
 		"stateRoot":        hexutil.Bytes(header.AppHash),
 		"miner":            validatorAddr,
-		"mixHash":          common.Hash{},
+		"mixHash":          mixHash,
 		"difficulty":       (*hexutil.Big)(big.NewInt(0)),
 		"extraData":        "0x",
 		"size":             hexutil.Uint64(size),
@@ -349,6 +350,23 @@ func BaseFeeFromEvents(events []abci.Event) *big.Int {
 	return nil
 }
 
+// BlockRandomFromEvents parses the randomness of the block from the evm end block events, it
+// returns the zero hash if the event isn't found.
+func BlockRandomFromEvents(events []abci.Event) common.Hash {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeBlockRandom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyBlockRandom {
+				return common.HexToHash(attr.Value)
+			}
+		}
+	}
+	return common.Hash{}
+}
+
 // CheckTxFee is an internal function used to check whether the fee of
 // the given transaction is _reasonable_(under the cap).
 func CheckTxFee(gasPrice *big.Int, gas uint64, cap float64) error {
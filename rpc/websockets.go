@@ -18,24 +18,36 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cometbft/cometbft/libs/log"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	"github.com/servprotocolorg/serv/v12/rpc/backend"
 	"github.com/servprotocolorg/serv/v12/rpc/ethereum/pubsub"
 	rpcfilters "github.com/servprotocolorg/serv/v12/rpc/namespaces/ethereum/eth/filters"
 	"github.com/servprotocolorg/serv/v12/rpc/types"
 	"github.com/servprotocolorg/serv/v12/server/config"
+	evertypes "github.com/servprotocolorg/serv/v12/types"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 )
 
 type WebsocketsServer interface {
-	Start()
+	http.Handler
+
+	// Start serves the websocket connections on the ws address, if the server shares the port of
+	// the JSON-RPC server it's mounted on its listener as an http.Handler instead.
+	Start() error
+	// Stop stops serving the websocket connections and closes the open ones.
+	Stop() error
+	// IsRunning returns true if the server serves the websocket connections.
+	IsRunning() bool
 }
 
 type SubscriptionResponseJSON struct {
@@ -73,33 +85,67 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	limiter  *RequestLimiter
+	// sharedPort is true if the server is mounted on the JSON-RPC server listener
+	sharedPort bool
+
+	mtx     sync.Mutex
+	running bool
+	httpSrv *http.Server
+	conns   map[*wsConn]struct{}
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	limiter *RequestLimiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcAddr:    "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
+		wsAddr:     cfg.JSONRPC.WsAddress,
+		certFile:   cfg.TLS.CertificatePath,
+		keyFile:    cfg.TLS.KeyPath,
+		api:        newPubSubAPI(clientCtx, logger, tmWSClient),
+		logger:     logger,
+		limiter:    limiter,
+		sharedPort: cfg.JSONRPC.SharedPort(),
+		conns:      make(map[*wsConn]struct{}),
 	}
 }
 
-func (s *websocketsServer) Start() {
+func (s *websocketsServer) Start() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.running {
+		return errors.New("websocket server already running")
+	}
+	if s.sharedPort {
+		s.running = true
+		return nil
+	}
+
+	ln, err := net.Listen("tcp", s.wsAddr)
+	if err != nil {
+		return err
+	}
+
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
+	/* #nosec G112 -- the websocket connections are long lived */
+	httpSrv := &http.Server{Handler: ws}
 
 	go func() {
 		var err error
-		/* #nosec G114 -- http functions have no support for timeouts */
 		if s.certFile == "" || s.keyFile == "" {
-			err = http.ListenAndServe(s.wsAddr, ws)
+			err = httpSrv.Serve(ln)
 		} else {
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws)
+			err = httpSrv.ServeTLS(ln, s.certFile, s.keyFile)
 		}
 
 		if err != nil {
@@ -110,25 +156,75 @@ func (s *websocketsServer) Start() {
 			s.logger.Error("failed to start HTTP server for WS", "error", err.Error())
 		}
 	}()
+
+	s.httpSrv = httpSrv
+	s.running = true
+	return nil
+}
+
+func (s *websocketsServer) Stop() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if !s.running {
+		return errors.New("websocket server not running")
+	}
+	s.running = false
+
+	// the hijacked websocket connections aren't closed by the http server
+	for conn := range s.conns {
+		_ = conn.Close() // #nosec G703
+	}
+	if s.httpSrv != nil {
+		err := s.httpSrv.Close()
+		s.httpSrv = nil
+		return err
+	}
+	return nil
+}
+
+func (s *websocketsServer) IsRunning() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.running
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.IsRunning() {
+		http.Error(w, "websocket server not running", http.StatusServiceUnavailable)
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool {
 			return true
 		},
 	}
 
+	// the token of the authenticated connections is verified once, on the upgrade request
+	authenticated := s.limiter == nil || s.limiter.isAuthenticated(r)
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		s.logger.Debug("websocket upgrade failed", "error", err.Error())
 		return
 	}
 
-	s.readLoop(&wsConn{
+	c := &wsConn{
 		mux:  new(sync.Mutex),
 		conn: conn,
-	})
+	}
+	s.mtx.Lock()
+	s.conns[c] = struct{}{}
+	s.mtx.Unlock()
+	defer func() {
+		s.mtx.Lock()
+		delete(s.conns, c)
+		s.mtx.Unlock()
+	}()
+
+	s.readLoop(c, clientIP(r.RemoteAddr), authenticated)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -169,7 +265,7 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
+func (s *websocketsServer) readLoop(wsConn *wsConn, ip string, authenticated bool) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
 	defer func() {
@@ -188,6 +284,13 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if s.limiter != nil {
+			if id, lerr := s.limiter.checkMessage(ip, mb, false, authenticated); lerr != nil {
+				_ = wsConn.WriteJSON(newLimitErrorResponse(id, lerr)) // #nosec G703
+				continue
+			}
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -315,6 +418,9 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.limiter != nil {
+		req.Header.Set(forwardedRequestHeader, s.limiter.forwardToken)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -370,7 +476,18 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		fullTx := false
+		if len(params) > 1 && params[1] != nil {
+			if fullTx, ok = params[1].(bool); !ok {
+				return nil, errors.New("invalid fullTx parameter, must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
+	case "transactionReceipts":
+		if len(params) > 1 {
+			return api.subscribeTransactionReceipts(wsConn, subID, params[1])
+		}
+		return api.subscribeTransactionReceipts(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -403,7 +520,8 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					continue
 				}
 
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				random := types.BlockRandomFromEvents(data.ResultEndBlock.Events)
+				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee, random)
 
 				// write to ws conn
 				res := &SubscriptionNotification{
@@ -618,7 +736,17 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// subscribePendingTransactions notifies the hashes of the Ethereum txs, or the full txs if
+// fullTx is true.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
+	var chainID *big.Int
+	if fullTx {
+		var err error
+		if chainID, err = evertypes.ParseChainID(api.clientCtx.ChainID); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the chain id")
+		}
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -643,13 +771,23 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					var result interface{} = ethTx.Hash
+					if fullTx {
+						rpcTx, err := types.NewTransactionFromMsg(ethTx, common.Hash{}, 0, 0, nil, chainID)
+						if err != nil {
+							api.logger.Debug("failed to build the rpc transaction", "hash", ethTx.Hash, "error", err.Error())
+							continue
+						}
+						result = rpcTx
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -676,8 +814,410 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// syncingResult is the geth compatible notification of a syncing subscription
+type syncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  syncingStatus `json:"status"`
+}
+
+type syncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// subscribeSyncing notifies the changes of the catching up state of the node, it checks the
+// CometBFT status on every new block header. Like geth, it notifies `false` once the node caught
+// up and the sync status once it started catching up.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT rpc client")
+	}
+
+	tracker, err := newSyncingTracker(api.clientCtx.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+
+	notify := func() {
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       tracker.result(),
+			},
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	}
+
+	go func() {
+		// notify the current state if the node is catching up
+		if tracker.catchingUp {
+			notify()
+		}
+
+		headersCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case _, ok := <-headersCh:
+				if !ok {
+					return
+				}
+
+				changed, err := tracker.update()
+				if err != nil {
+					api.logger.Debug("failed to query the node status", "error", err.Error())
+					continue
+				}
+				if changed {
+					notify()
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Syncing WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// syncingTracker tracks the catching up state of the node for a syncing subscription. Like geth,
+// the starting block is the latest block of the node when it started catching up, or when the
+// subscription was created if the node was already catching up.
+type syncingTracker struct {
+	client        tmrpcclient.StatusClient
+	catchingUp    bool
+	startingBlock int64
+	currentBlock  int64
+}
+
+// newSyncingTracker creates the tracker of the current status of the node.
+func newSyncingTracker(client tmrpcclient.StatusClient) (*syncingTracker, error) {
+	status, err := client.Status(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the node status")
+	}
+
+	return &syncingTracker{
+		client:        client,
+		catchingUp:    status.SyncInfo.CatchingUp,
+		startingBlock: status.SyncInfo.LatestBlockHeight,
+		currentBlock:  status.SyncInfo.LatestBlockHeight,
+	}, nil
+}
+
+// update queries the status of the node and returns true if the catching up state changed.
+func (t *syncingTracker) update() (bool, error) {
+	status, err := t.client.Status(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	t.currentBlock = status.SyncInfo.LatestBlockHeight
+	if status.SyncInfo.CatchingUp == t.catchingUp {
+		return false, nil
+	}
+
+	t.catchingUp = status.SyncInfo.CatchingUp
+	if t.catchingUp {
+		t.startingBlock = t.currentBlock
+	}
+	return true, nil
+}
+
+// result returns the notification of the current state, `false` if the node isn't catching up.
+func (t *syncingTracker) result() interface{} {
+	if !t.catchingUp {
+		return false
+	}
+
+	return &syncingResult{
+		Syncing: true,
+		Status: syncingStatus{
+			StartingBlock: hexutil.Uint64(t.startingBlock),
+			CurrentBlock:  hexutil.Uint64(t.currentBlock),
+			// CometBFT doesn't expose the height of the peers, the latest block is the highest
+			// one known by the node
+			HighestBlock: hexutil.Uint64(t.currentBlock),
+		},
+	}
+}
+
+// receiptsCriteria filters the receipts of a transactionReceipts subscription. A receipt matches
+// if its tx hash is one of the hashes and its sender, recipient or created contract is one of the
+// addresses, the empty lists match all the receipts.
+type receiptsCriteria struct {
+	TransactionHashes []common.Hash    `json:"transactionHashes"`
+	Addresses         []common.Address `json:"addresses"`
+}
+
+// parseReceiptsCriteria parses the optional criteria of a transactionReceipts subscription.
+func parseReceiptsCriteria(extra interface{}) (receiptsCriteria, error) {
+	var crit receiptsCriteria
+	if extra == nil {
+		return crit, nil
+	}
+	if _, ok := extra.(map[string]interface{}); !ok {
+		return crit, errors.New("invalid criteria")
+	}
+
+	bz, err := json.Marshal(extra)
+	if err != nil {
+		return crit, errors.Wrap(err, "invalid criteria")
+	}
+	if err := json.Unmarshal(bz, &crit); err != nil {
+		return crit, errors.Wrap(err, "invalid criteria")
+	}
+	return crit, nil
+}
+
+func (crit receiptsCriteria) matches(receipt *types.RPCReceipt) bool {
+	if len(crit.TransactionHashes) > 0 {
+		found := false
+		for _, hash := range crit.TransactionHashes {
+			if hash == receipt.TransactionHash {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(crit.Addresses) == 0 {
+		return true
+	}
+	for _, addr := range crit.Addresses {
+		if addr == receipt.From ||
+			(receipt.To != nil && addr == *receipt.To) ||
+			(receipt.ContractAddress != nil && addr == *receipt.ContractAddress) {
+			return true
+		}
+	}
+	return false
+}
+
+// receiptsBlock is the information of the block of the receipts.
+type receiptsBlock struct {
+	height  int64
+	hash    common.Hash
+	baseFee *big.Int
+	// txsGasUsed is the gas used by each tx of the block
+	txsGasUsed []int64
+}
+
+// queryReceiptsBlock queries the block and the block results of the height, they're saved by
+// CometBFT before the events of its txs are published.
+func (api *pubSubAPI) queryReceiptsBlock(height int64) (*receiptsBlock, error) {
+	sc, ok := api.clientCtx.Client.(tmrpcclient.SignClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	resBlock, err := sc.Block(context.Background(), &height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query block %d", height)
+	}
+	blockRes, err := sc.BlockResults(context.Background(), &height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query block results %d", height)
+	}
+
+	block := &receiptsBlock{
+		height:     height,
+		hash:       common.BytesToHash(resBlock.BlockID.Hash.Bytes()),
+		baseFee:    types.BaseFeeFromEvents(blockRes.BeginBlockEvents),
+		txsGasUsed: make([]int64, len(blockRes.TxsResults)),
+	}
+	for i, txResult := range blockRes.TxsResults {
+		block.txsGasUsed[i] = txResult.GasUsed
+	}
+	return block, nil
+}
+
+// buildReceipts builds the receipts of the Ethereum txs of the committed tx.
+func buildReceipts(
+	txConfig client.TxConfig,
+	data tmtypes.EventDataTx,
+	block *receiptsBlock,
+	chainID *big.Int,
+) ([]*types.RPCReceipt, error) {
+	tx, err := txConfig.TxDecoder()(data.Tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode tx")
+	}
+
+	parsedTxs, err := types.ParseTxResult(&data.Result, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse tx result")
+	}
+
+	cumulativeGasUsed := uint64(0)
+	for _, gasUsed := range block.txsGasUsed[:data.Index] {
+		cumulativeGasUsed += uint64(gasUsed) // #nosec G701 -- checked for int overflow already
+	}
+
+	receipts := make([]*types.RPCReceipt, 0, len(parsedTxs.Txs))
+	for i, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		parsedTx := parsedTxs.GetTxByMsgIndex(i)
+		if parsedTx == nil {
+			continue
+		}
+		if parsedTx.EthTxIndex == -1 {
+			return nil, errors.Errorf("can't find index of ethereum tx %s", parsedTx.Hash)
+		}
+
+		logs, err := backend.TxLogsFromEvents(data.Result.Events, i)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse tx logs")
+		}
+
+		var baseFee *big.Int
+		if ethMsg.AsTransaction().Type() == ethtypes.DynamicFeeTxType {
+			baseFee = block.baseFee
+		}
+
+		receipt, err := types.NewRPCReceipt(
+			ethMsg,
+			hexutil.Uint64(parsedTx.EthTxIndex),
+			!parsedTx.Failed,
+			hexutil.Uint64(parsedTx.GasUsed),
+			hexutil.Uint64(cumulativeGasUsed+parsedTxs.AccumulativeGasUsed(i)),
+			baseFee,
+			logs,
+			block.hash,
+			hexutil.Uint64(block.height),
+			chainID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// subscribeTransactionReceipts notifies the receipts of the committed Ethereum txs matching the
+// criteria, the receipts of a tx are notified together.
+func (api *pubSubAPI) subscribeTransactionReceipts(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("transactionReceipts subscription requires a CometBFT rpc client")
+	}
+
+	crit, err := parseReceiptsCriteria(extra)
+	if err != nil {
+		api.logger.Debug("invalid receipts criteria", "type", fmt.Sprintf("%T", extra))
+		return nil, err
+	}
+
+	chainID, err := evertypes.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the chain id")
+	}
+
+	sub, unsubFn, err := api.events.SubscribeEthTxs()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating receipts filter")
+	}
+
+	go func() {
+		txsCh := sub.Event()
+		errCh := sub.Err()
+		// the txs of a block are published in order, the block is only queried once
+		var block *receiptsBlock
+		for {
+			select {
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
+				data, ok := ev.Data.(tmtypes.EventDataTx)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+					continue
+				}
+
+				if block == nil || block.height != data.Height {
+					if block, err = api.queryReceiptsBlock(data.Height); err != nil {
+						api.logger.Debug("failed to query the block of the receipts", "height", data.Height, "error", err.Error())
+						continue
+					}
+				}
+				if int(data.Index) >= len(block.txsGasUsed) {
+					api.logger.Debug("tx index out of the block results", "height", data.Height, "index", data.Index)
+					continue
+				}
+
+				receipts, err := buildReceipts(api.clientCtx.TxConfig, data, block, chainID)
+				if err != nil {
+					api.logger.Debug("failed to build the receipts", "height", data.Height, "index", data.Index, "error", err.Error())
+					continue
+				}
+
+				matched := make([]*types.RPCReceipt, 0, len(receipts))
+				for _, receipt := range receipts {
+					if crit.matches(receipt) {
+						matched = append(matched, receipt)
+					}
+				}
+				if len(matched) == 0 {
+					continue
+				}
+
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       matched,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing receipts, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close() // #nosec G703
+						}
+					}, api.logger, "closing websocket peer sub")
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping TransactionReceipts WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
+	"github.com/servprotocolorg/serv/v12/rpc/types"
+)
+
+func TestReceiptsCriteria(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	from := common.HexToAddress("0x0a")
+	to := common.HexToAddress("0x0b")
+	contract := common.HexToAddress("0x0c")
+
+	call := &types.RPCReceipt{TransactionHash: hash, From: from, To: &to}
+	create := &types.RPCReceipt{TransactionHash: common.HexToHash("0x02"), From: from, ContractAddress: &contract}
+
+	testCases := []struct {
+		name      string
+		extra     interface{}
+		expErr    bool
+		expCall   bool
+		expCreate bool
+	}{
+		{"no criteria", nil, false, true, true},
+		{"empty criteria", map[string]interface{}{}, false, true, true},
+		{"invalid criteria type", "0x01", true, false, false},
+		{"invalid hash", map[string]interface{}{"transactionHashes": []interface{}{1}}, true, false, false},
+		{"tx hash", map[string]interface{}{"transactionHashes": []interface{}{hash.Hex()}}, false, true, false},
+		{"sender", map[string]interface{}{"addresses": []interface{}{from.Hex()}}, false, true, true},
+		{"recipient", map[string]interface{}{"addresses": []interface{}{to.Hex()}}, false, true, false},
+		{"created contract", map[string]interface{}{"addresses": []interface{}{contract.Hex()}}, false, false, true},
+		{
+			"tx hash and address",
+			map[string]interface{}{
+				"transactionHashes": []interface{}{hash.Hex()},
+				"addresses":         []interface{}{contract.Hex()},
+			},
+			false, false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crit, err := parseReceiptsCriteria(tc.extra)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCall, crit.matches(call))
+			require.Equal(t, tc.expCreate, crit.matches(create))
+		})
+	}
+}
+
+func TestSyncingTracker(t *testing.T) {
+	client := mocks.NewClient(t)
+	setStatus := func(catchingUp bool, latest int64) {
+		client.On("Status", mock.Anything).Return(&coretypes.ResultStatus{
+			SyncInfo: coretypes.SyncInfo{CatchingUp: catchingUp, LatestBlockHeight: latest, EarliestBlockHeight: 1},
+		}, nil).Once()
+	}
+	syncing := func(starting, current uint64) interface{} {
+		return &syncingResult{
+			Syncing: true,
+			Status: syncingStatus{
+				StartingBlock: hexutil.Uint64(starting),
+				CurrentBlock:  hexutil.Uint64(current),
+				HighestBlock:  hexutil.Uint64(current),
+			},
+		}
+	}
+
+	setStatus(false, 10)
+	tracker, err := newSyncingTracker(client)
+	require.NoError(t, err)
+	require.False(t, tracker.catchingUp)
+
+	testCases := []struct {
+		name       string
+		catchingUp bool
+		latest     int64
+		expChanged bool
+		expResult  interface{}
+	}{
+		{"caught up", false, 11, false, false},
+		{"started catching up", true, 12, true, syncing(12, 12)},
+		{"still catching up", true, 20, false, syncing(12, 20)},
+		{"caught up again", false, 30, true, false},
+		{"catching up from a later block", true, 31, true, syncing(31, 31)},
+	}
+	for _, tc := range testCases {
+		setStatus(tc.catchingUp, tc.latest)
+		changed, err := tracker.update()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expChanged, changed, tc.name)
+		require.Equal(t, tc.expResult, tracker.result(), tc.name)
+	}
+
+	// the state is kept if the status can't be queried
+	client.On("Status", mock.Anything).Return(nil, errors.New("unavailable")).Once()
+	changed, err := tracker.update()
+	require.Error(t, err)
+	require.False(t, changed)
+	require.Equal(t, syncing(31, 31), tracker.result())
+
+	// the subscription created while catching up starts at the latest block
+	setStatus(true, 40)
+	tracker, err = newSyncingTracker(client)
+	require.NoError(t, err)
+	require.Equal(t, syncing(40, 40), tracker.result())
+}
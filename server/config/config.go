@@ -3,12 +3,16 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
+	tmstrings "github.com/cometbft/cometbft/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -69,6 +73,36 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// IndexerBackendKV indexes the eth txs in the key-value db of the node, it's the default backend
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendPostgres indexes the eth txs in a PostgreSQL database
+	IndexerBackendPostgres = "postgres"
+
+	// IndexerBackendSQLite indexes the eth txs in a SQLite database
+	IndexerBackendSQLite = "sqlite"
+
+	// DefaultIndexerBackend is the default backend of the eth tx indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// AdminAPINamespace is the JSON-RPC namespace administrating the node, it requires the JWT authentication
+	AdminAPINamespace = "admin"
+
+	// DefaultBatchRequestLimit is the default max number of requests in a batch (unlimited = 0)
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default max size in bytes of a batch response (unlimited = 0)
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+
+	// DefaultRateLimitPerIP is the default number of requests per second allowed per client IP (unlimited = 0)
+	DefaultRateLimitPerIP float64 = 0
+
+	// DefaultRateLimitBurst is the default number of requests a client IP can burst above the rate limit
+	DefaultRateLimitBurst = 100
+
+	// DefaultJWTSecretPath is the default path of the JWT secret file, relative to the node home directory
+	DefaultJWTSecretPath = "config/jwtsecret"
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -98,8 +132,12 @@ type JSONRPCConfig struct {
 	API []string `mapstructure:"api"`
 	// Address defines the HTTP server to listen on
 	Address string `mapstructure:"address"`
-	// WsAddress defines the WebSocket server to listen on
+	// WsAddress defines the WebSocket server to listen on, the HTTP and WebSocket servers share the
+	// listener if it's the same as the HTTP server address
 	WsAddress string `mapstructure:"ws-address"`
+	// IPCPath defines the Unix domain socket of the IPC server, relative to the node home directory
+	// if not absolute (empty=disabled)
+	IPCPath string `mapstructure:"ipc-path"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// EVMTimeout is the global timeout for eth-call.
@@ -130,6 +168,72 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// IndexerBackend defines the database of the eth tx indexer (kv|postgres|sqlite)
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerDSN defines the data source name of the SQL database of the eth tx indexer
+	IndexerDSN string `mapstructure:"indexer-dsn"`
+	// BatchRequestLimit defines the max number of requests in a batch (0=unlimited)
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize defines the max size in bytes of a batch response (0=unlimited),
+	// the responses of single requests aren't limited
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// RateLimitPerIP defines the number of requests per second allowed per client IP (0=unlimited)
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitBurst defines the number of requests a client IP can burst above the rate limits
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// MethodRateLimits defines the per client IP rate limits of methods, formatted as
+	// `method=requests-per-second[:burst]`
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// AllowedMethods defines the only methods allowed if not empty, `namespace_*` matches all the
+	// methods of the namespace
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the methods rejected, `namespace_*` matches all the methods of the namespace
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// AuthAPI defines a list of JSON-RPC namespaces that require the JWT authentication
+	AuthAPI []string `mapstructure:"auth-api"`
+	// JWTSecret defines the hex encoded JWT secret file of the authenticated namespaces, relative to
+	// the node home directory if not absolute
+	JWTSecret string `mapstructure:"jwt-secret"`
+}
+
+// MethodRateLimit is the rate limit of a JSON-RPC method.
+type MethodRateLimit struct {
+	// Method is the method name, or `namespace_*` for all the methods of the namespace
+	Method string
+	// Rate is the number of requests per second
+	Rate float64
+	// Burst is the number of requests allowed above the rate, defaults to the rate
+	Burst int
+}
+
+// ParseMethodRateLimits parses the `method=requests-per-second[:burst]` method rate limits.
+func ParseMethodRateLimits(limits []string) ([]MethodRateLimit, error) {
+	res := make([]MethodRateLimit, 0, len(limits))
+	seen := make(map[string]bool)
+	for _, limit := range limits {
+		method, value, ok := strings.Cut(strings.TrimSpace(limit), "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method rate limit '%s', expect: method=requests-per-second[:burst]", limit)
+		}
+		if seen[method] {
+			return nil, fmt.Errorf("repeated method rate limit '%s'", method)
+		}
+		seen[method] = true
+
+		rateStr, burstStr, hasBurst := strings.Cut(value, ":")
+		rate, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate of method rate limit '%s', expect a positive number", limit)
+		}
+		burst := int(math.Ceil(rate))
+		if hasBurst {
+			if burst, err = strconv.Atoi(burstStr); err != nil || burst <= 0 {
+				return nil, fmt.Errorf("invalid burst of method rate limit '%s', expect a positive integer", limit)
+			}
+		}
+		res = append(res, MethodRateLimit{Method: method, Rate: rate, Burst: burst})
+	}
+	return res, nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -195,7 +299,7 @@ func DefaultEVMConfig() *EVMConfig {
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !tmstrings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -209,7 +313,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -232,7 +336,49 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		IndexerBackend:           DefaultIndexerBackend,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		RateLimitPerIP:           DefaultRateLimitPerIP,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		JWTSecret:                DefaultJWTSecretPath,
+	}
+}
+
+// SharedPort returns true if the HTTP and WebSocket servers share the same listener, i.e. their
+// addresses have the same port and host. The unspecified hosts (empty, 0.0.0.0 and ::) are
+// equivalent.
+func (c JSONRPCConfig) SharedPort() bool {
+	httpHost, httpPort, ok := normalizeListenAddress(c.Address)
+	if !ok {
+		return c.WsAddress == c.Address
+	}
+	wsHost, wsPort, ok := normalizeListenAddress(c.WsAddress)
+	if !ok {
+		return false
+	}
+	return httpHost == wsHost && httpPort == wsPort
+}
+
+// normalizeListenAddress returns the canonical host and the port number of the listen address,
+// the unspecified hosts are normalized to the empty host.
+func normalizeListenAddress(address string) (host string, port int, ok bool) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, false
+	}
+	port, err = net.LookupPort("tcp", portStr)
+	if err != nil {
+		return "", 0, false
 	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.IsUnspecified() {
+			return "", port, true
+		}
+		return ip.String(), port, true
+	}
+	return strings.ToLower(host), port, true
 }
 
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
@@ -273,6 +419,42 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	switch c.IndexerBackend {
+	case IndexerBackendKV, "":
+		// the configs created before the indexer backends default to the kv backend
+	case IndexerBackendPostgres, IndexerBackendSQLite:
+		if c.IndexerDSN == "" {
+			return fmt.Errorf("JSON-RPC indexer DSN cannot be empty for the %s indexer backend", c.IndexerBackend)
+		}
+	default:
+		return fmt.Errorf("invalid JSON-RPC indexer backend '%s', expect: %s|%s|%s",
+			c.IndexerBackend, IndexerBackendKV, IndexerBackendPostgres, IndexerBackendSQLite)
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.RateLimitPerIP < 0 {
+		return errors.New("JSON-RPC rate limit per IP cannot be negative")
+	}
+
+	if c.RateLimitPerIP > 0 && c.RateLimitBurst <= 0 {
+		return errors.New("JSON-RPC rate limit burst must be positive if the rate limit per IP is enabled")
+	}
+
+	if _, err := ParseMethodRateLimits(c.MethodRateLimits); err != nil {
+		return errorsmod.Wrap(err, "invalid JSON-RPC method rate limits")
+	}
+
+	if len(c.AuthAPI) > 0 && c.JWTSecret == "" {
+		return errors.New("JSON-RPC JWT secret cannot be empty if namespaces require the authentication")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -283,9 +465,24 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	// the admin namespace administrates the node, it's only exposed to the authenticated clients
+	if seenAPIs[AdminAPINamespace] && !c.RequiresAuth(AdminAPINamespace) {
+		return fmt.Errorf("JSON-RPC API namespace '%s' must be in the auth-api namespaces", AdminAPINamespace)
+	}
+
 	return nil
 }
 
+// RequiresAuth returns true if the JSON-RPC namespace requires the JWT authentication.
+func (c JSONRPCConfig) RequiresAuth(namespace string) bool {
+	for _, api := range c.AuthAPI {
+		if api == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -329,6 +526,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			API:                      v.GetStringSlice("json-rpc.api"),
 			Address:                  v.GetString("json-rpc.address"),
 			WsAddress:                v.GetString("json-rpc.ws-address"),
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
 			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
 			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
 			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
@@ -341,6 +539,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
+			IndexerBackend:           v.GetString("json-rpc.indexer-backend"),
+			IndexerDSN:               v.GetString("json-rpc.indexer-dsn"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			BatchResponseMaxSize:     v.GetInt("json-rpc.batch-response-max-size"),
+			RateLimitPerIP:           v.GetFloat64("json-rpc.rate-limit-per-ip"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			MethodRateLimits:         v.GetStringSlice("json-rpc.method-rate-limits"),
+			AllowedMethods:           v.GetStringSlice("json-rpc.allowed-methods"),
+			DeniedMethods:            v.GetStringSlice("json-rpc.denied-methods"),
+			AuthAPI:                  v.GetStringSlice("json-rpc.auth-api"),
+			JWTSecret:                v.GetString("json-rpc.jwt-secret"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -12,3 +12,122 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidateIndexerBackend(t *testing.T) {
+	testCases := []struct {
+		name     string
+		backend  string
+		dsn      string
+		expError bool
+	}{
+		{"default kv backend", IndexerBackendKV, "", false},
+		{"unset backend", "", "", false},
+		{"postgres backend", IndexerBackendPostgres, "postgres://localhost:5432/evmindexer", false},
+		{"sqlite backend", IndexerBackendSQLite, "evmindexer.db", false},
+		{"sql backend without dsn", IndexerBackendPostgres, "", true},
+		{"unknown backend", "mysql", "localhost:3306", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.IndexerBackend = tc.backend
+			cfg.IndexerDSN = tc.dsn
+			err := cfg.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseMethodRateLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limits   []string
+		expRes   []MethodRateLimit
+		expError bool
+	}{
+		{"empty", nil, []MethodRateLimit{}, false},
+		{
+			"rate and burst",
+			[]string{"eth_getLogs=5:10", "trace_*=0.5"},
+			[]MethodRateLimit{{Method: "eth_getLogs", Rate: 5, Burst: 10}, {Method: "trace_*", Rate: 0.5, Burst: 1}},
+			false,
+		},
+		{"missing rate", []string{"eth_getLogs"}, nil, true},
+		{"missing method", []string{"=5"}, nil, true},
+		{"zero rate", []string{"eth_getLogs=0"}, nil, true},
+		{"invalid burst", []string{"eth_getLogs=5:-1"}, nil, true},
+		{"repeated method", []string{"eth_getLogs=5", "eth_getLogs=10"}, nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ParseMethodRateLimits(tc.limits)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRes, res)
+
+			cfg := DefaultJSONRPCConfig()
+			cfg.MethodRateLimits = tc.limits
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateAdminAPI(t *testing.T) {
+	testCases := []struct {
+		name     string
+		api      []string
+		authAPI  []string
+		expError bool
+	}{
+		{"admin disabled", []string{"eth"}, nil, false},
+		{"admin authenticated", []string{"eth", "admin"}, []string{"admin"}, false},
+		{"admin not authenticated", []string{"eth", "admin"}, nil, true},
+		{"other namespace authenticated", []string{"eth", "admin"}, []string{"personal"}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.API = tc.api
+			cfg.AuthAPI = tc.authAPI
+			cfg.JWTSecret = DefaultJWTSecretPath
+			err := cfg.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigSharedPort(t *testing.T) {
+	testCases := []struct {
+		address   string
+		wsAddress string
+		expShared bool
+	}{
+		{"0.0.0.0:8545", "0.0.0.0:8545", true},
+		{":8545", "0.0.0.0:8545", true},
+		{"[::]:8545", ":8545", true},
+		{"127.0.0.1:8545", "127.0.0.1:8545", true},
+		{"LOCALHOST:8545", "localhost:8545", true},
+		{"0.0.0.0:http", "0.0.0.0:80", true},
+		{"0.0.0.0:8545", "0.0.0.0:8546", false},
+		{"0.0.0.0:8545", "127.0.0.1:8545", false},
+		{"127.0.0.1:8545", "localhost:8545", false},
+		{"0.0.0.0:8545", "invalid", false},
+	}
+	for _, tc := range testCases {
+		cfg := DefaultJSONRPCConfig()
+		cfg.Address = tc.address
+		cfg.WsAddress = tc.wsAddress
+		require.Equal(t, tc.expShared, cfg.SharedPort(), "%s %s", tc.address, tc.wsAddress)
+	}
+}
@@ -41,6 +41,7 @@ const (
 	JSONRPCAPI                 = "json-rpc.api"
 	JSONRPCAddress             = "json-rpc.address"
 	JSONWsAddress              = "json-rpc.ws-address"
+	JSONRPCIPCPath             = "json-rpc.ipc-path"
 	JSONRPCGasCap              = "json-rpc.gas-cap"
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
@@ -56,6 +57,17 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCIndexerBackend           = "json-rpc.indexer-backend"
+	JSONRPCIndexerDSN               = "json-rpc.indexer-dsn"
+	JSONRPCBatchRequestLimit        = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize     = "json-rpc.batch-response-max-size"
+	JSONRPCRateLimitPerIP           = "json-rpc.rate-limit-per-ip"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCMethodRateLimits         = "json-rpc.method-rate-limits"
+	JSONRPCAllowedMethods           = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods            = "json-rpc.denied-methods"
+	JSONRPCAuthAPI                  = "json-rpc.auth-api"
+	JSONRPCJWTSecret                = "json-rpc.jwt-secret"
 )
 
 // EVM flags
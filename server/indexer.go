@@ -0,0 +1,51 @@
+package server
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	// register the PostgreSQL and SQLite drivers of the SQL eth tx indexer
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	"github.com/servprotocolorg/serv/v12/indexer"
+	"github.com/servprotocolorg/serv/v12/server/config"
+	evertypes "github.com/servprotocolorg/serv/v12/types"
+)
+
+// EVMIndexer is the eth tx indexer of the JSON-RPC server and the index-eth-tx command.
+type EVMIndexer interface {
+	evertypes.EVMTxIndexer
+
+	// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+	FirstIndexedBlock() (int64, error)
+}
+
+// OpenEVMIndexer opens the eth tx indexer of the configured backend, the kv backend uses the
+// custom eth indexer db of the node and the SQL backends the database of the indexer DSN.
+func OpenEVMIndexer(ctx *server.Context, cfg config.JSONRPCConfig, logger log.Logger, clientCtx client.Context) (EVMIndexer, error) {
+	switch cfg.IndexerBackend {
+	case config.IndexerBackendKV, "":
+		idxDB, err := OpenIndexerDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewKVIndexer(idxDB, logger, clientCtx), nil
+	case config.IndexerBackendPostgres, config.IndexerBackendSQLite:
+		dialect := indexer.SQLDialect(cfg.IndexerBackend)
+		db, err := sql.Open(dialect.DriverName(), cfg.IndexerDSN)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Ping(); err != nil {
+			return nil, fmt.Errorf("failed to connect to the %s indexer database: %w", cfg.IndexerBackend, err)
+		}
+		return indexer.NewSQLIndexer(db, dialect, logger, clientCtx)
+	default:
+		return nil, fmt.Errorf("unknown indexer backend: %s", cfg.IndexerBackend)
+	}
+}
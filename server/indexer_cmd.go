@@ -6,13 +6,25 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/servprotocolorg/serv/v12/indexer"
+	"github.com/servprotocolorg/serv/v12/server/config"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmnode "github.com/cometbft/cometbft/node"
 	sm "github.com/cometbft/cometbft/state"
 	tmstore "github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+const (
+	flagKeepRecent = "keep-recent"
+	flagFrom       = "from"
+	flagTo         = "to"
+
+	// pruneBatchBlocks is the number of blocks pruned in a single db batch.
+	pruneBatchBlocks = 1000
+)
+
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "index-eth-tx [backward|forward]",
@@ -23,55 +35,28 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The prune, reindex and verify subcommands maintain the indexer db of the kv indexer backend.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
 			direction := args[0]
 			if direction != "backward" && direction != "forward" {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
-			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
-				return err
-			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
-
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			stores, err := openIndexerStores(cmd)
 			if err != nil {
 				return err
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
-
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
-			if err != nil {
-				return err
-			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
+			idxer := stores.indexer
 
 			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadABCIResponses(height)
+				blk, txResults, err := stores.loadBlock(height)
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+				if err := idxer.IndexBlock(blk, txResults); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -86,7 +71,7 @@ func NewIndexTxCmd() *cobra.Command {
 				}
 				if first == -1 {
 					// start from the latest block if indexer db is empty
-					first = blockStore.Height()
+					first = stores.blockStore.Height()
 				}
 				for i := first - 1; i > 0; i-- {
 					if err := indexBlock(i); err != nil {
@@ -105,7 +90,7 @@ func NewIndexTxCmd() *cobra.Command {
 					// start from genesis if empty
 					latest = 0
 				}
-				for i := latest + 1; i <= blockStore.Height(); i++ {
+				for i := latest + 1; i <= stores.blockStore.Height(); i++ {
 					if err := indexBlock(i); err != nil {
 						return err
 					}
@@ -120,5 +105,262 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.AddCommand(
+		newPruneIndexCmd(),
+		newReindexCmd(),
+		newVerifyIndexCmd(),
+	)
 	return cmd
 }
+
+func newPruneIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the indexed eth txs and logs of the old blocks",
+		Long:  "Prune the indexed eth txs and logs of the blocks below the most recent blocks of the chain to keep.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keepRecent, err := cmd.Flags().GetInt64(flagKeepRecent)
+			if err != nil {
+				return err
+			}
+			if keepRecent <= 0 {
+				return fmt.Errorf("--%s must be positive, got: %d", flagKeepRecent, keepRecent)
+			}
+
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			kvIndexer, err := stores.kvIndexer()
+			if err != nil {
+				return err
+			}
+
+			before := stores.blockStore.Height() - keepRecent + 1
+			first, err := kvIndexer.FirstIndexedBlock()
+			if err != nil {
+				return err
+			}
+			if first == -1 || first >= before {
+				fmt.Println("no blocks to prune")
+				return nil
+			}
+
+			for height := first; height < before; height += pruneBatchBlocks {
+				to := height + pruneBatchBlocks
+				if to > before {
+					to = before
+				}
+				if err := kvIndexer.PruneBlocks(to); err != nil {
+					return err
+				}
+				fmt.Println(to - 1)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagKeepRecent, 0, "Number of the most recent blocks of the chain to keep indexed")
+	return cmd
+}
+
+func newReindexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex",
+		Short: "Drop and rebuild the indexed eth txs and logs of a block range",
+		Long: `Drop and rebuild the indexed eth txs and logs of the [from, to] block range, to defaults to the latest block.
+The blocks are re-indexed from the last to the first one, so the range of blocks whose logs are indexed stays contiguous.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			kvIndexer, err := stores.kvIndexer()
+			if err != nil {
+				return err
+			}
+			from, to, err := stores.blockRange(cmd, 0, stores.blockStore.Height())
+			if err != nil {
+				return err
+			}
+
+			for height := to; height >= from; height-- {
+				blk, txResults, err := stores.loadBlock(height)
+				if err != nil {
+					return err
+				}
+				if err := kvIndexer.ReindexBlock(blk, txResults); err != nil {
+					return err
+				}
+				fmt.Println(height)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "First block of the range to re-index")
+	cmd.Flags().Int64(flagTo, 0, "Last block of the range to re-index (default the latest block)")
+	if err := cmd.MarkFlagRequired(flagFrom); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func newVerifyIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the indexed eth txs against the block results",
+		Long: `Cross-check the indexed eth tx results of the [from, to] block range against the CometBFT block results and report the mismatches.
+The range defaults to the indexed blocks.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			kvIndexer, err := stores.kvIndexer()
+			if err != nil {
+				return err
+			}
+
+			first, err := kvIndexer.FirstIndexedBlock()
+			if err != nil {
+				return err
+			}
+			last, err := kvIndexer.LastIndexedBlock()
+			if err != nil {
+				return err
+			}
+			if first == -1 && !cmd.Flags().Changed(flagFrom) && !cmd.Flags().Changed(flagTo) {
+				fmt.Println("no indexed blocks to verify")
+				return nil
+			}
+			from, to, err := stores.blockRange(cmd, first, last)
+			if err != nil {
+				return err
+			}
+
+			var mismatches int
+			for height := from; height <= to; height++ {
+				blk, txResults, err := stores.loadBlock(height)
+				if err != nil {
+					return err
+				}
+				blockMismatches, err := kvIndexer.VerifyBlock(blk, txResults)
+				if err != nil {
+					return err
+				}
+				for _, mismatch := range blockMismatches {
+					fmt.Printf("block %d: %s\n", height, mismatch)
+				}
+				mismatches += len(blockMismatches)
+			}
+
+			if mismatches > 0 {
+				return fmt.Errorf("%d mismatches found in blocks [%d, %d]", mismatches, from, to)
+			}
+			fmt.Printf("blocks [%d, %d] verified\n", from, to)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "First block of the range to verify (default the first indexed block)")
+	cmd.Flags().Int64(flagTo, 0, "Last block of the range to verify (default the last indexed block)")
+	return cmd
+}
+
+// indexerStores are the eth tx indexer and the local CometBFT stores of the index-eth-tx commands.
+type indexerStores struct {
+	indexer    EVMIndexer
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+}
+
+// openIndexerStores opens the eth tx indexer and the local tendermint db, because the local rpc
+// won't be available.
+func openIndexerStores(cmd *cobra.Command) (*indexerStores, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := serverCtx.Config
+	logger := serverCtx.Logger
+	srvCfg, err := config.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	idxer, err := OpenEVMIndexer(serverCtx, srvCfg.JSONRPC, logger.With("module", "evmindex"), clientCtx)
+	if err != nil {
+		logger.Error("failed to open evm indexer", "backend", srvCfg.JSONRPC.IndexerBackend, "error", err.Error())
+		return nil, err
+	}
+
+	tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexerStores{
+		indexer:    idxer,
+		blockStore: tmstore.NewBlockStore(tmdb),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		}),
+	}, nil
+}
+
+// kvIndexer returns the indexer if it's a kv indexer, the maintenance commands only support the
+// kv indexer backend.
+func (s *indexerStores) kvIndexer() (*indexer.KVIndexer, error) {
+	kvIndexer, ok := s.indexer.(*indexer.KVIndexer)
+	if !ok {
+		return nil, fmt.Errorf("only supported by the %s indexer backend", config.IndexerBackendKV)
+	}
+	return kvIndexer, nil
+}
+
+// loadBlock loads the block and its tx results.
+func (s *indexerStores) loadBlock(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error) {
+	blk := s.blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	resBlk, err := s.stateStore.LoadABCIResponses(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, resBlk.DeliverTxs, nil
+}
+
+// blockRange returns the [from, to] block range of the flags, defaulting to the given range.
+// The range must be within the blocks of the block store.
+func (s *indexerStores) blockRange(cmd *cobra.Command, from, to int64) (int64, int64, error) {
+	var err error
+	if cmd.Flags().Changed(flagFrom) {
+		if from, err = cmd.Flags().GetInt64(flagFrom); err != nil {
+			return 0, 0, err
+		}
+	}
+	if cmd.Flags().Changed(flagTo) {
+		if to, err = cmd.Flags().GetInt64(flagTo); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if from < 1 || from > to {
+		return 0, 0, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if latest := s.blockStore.Height(); to > latest {
+		return 0, 0, fmt.Errorf("block range [%d, %d] above the latest block %d", from, to, latest)
+	}
+	return from, to, nil
+}
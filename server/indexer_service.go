@@ -7,6 +7,7 @@ import (
 	"github.com/cometbft/cometbft/libs/service"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	evertypes "github.com/servprotocolorg/serv/v12/types"
 )
@@ -15,6 +16,12 @@ const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// SkippedBlocksRetryInterval is the minimum interval between the retries of the skipped
+	// blocks, they're retried once the indexer caught up with the chain.
+	SkippedBlocksRetryInterval = 5 * time.Minute
+
+	metricsKeyIndexer = "evm_indexer"
 )
 
 // EVMIndexerService indexes transactions for json-rpc service.
@@ -103,7 +110,10 @@ func (eis *EVMIndexerService) OnStart() error {
 		return
 	}
 
+	var lastSkippedBlocksRetry time.Time
 	for {
+		setIndexerMetrics(lastIndexedBlock, latestBlock)
+
 		if lastIndexedBlock >= latestBlock {
 			// nothing to index. wait for signal of new block
 
@@ -112,11 +122,16 @@ func (eis *EVMIndexerService) OnStart() error {
 				eis.txIdxr.Ready()
 				isIndexerMarkedReady = true
 
-				for h, _ := range startupIndexBlockFailureTracker {
+				for h := range startupIndexBlockFailureTracker {
 					eis.Logger.Error("skipped indexing block after multiple retries", "height", h)
 				}
 			}
 
+			if time.Since(lastSkippedBlocksRetry) >= SkippedBlocksRetryInterval {
+				eis.retrySkippedBlocks(ctx)
+				lastSkippedBlocksRetry = time.Now()
+			}
+
 			// wait
 			select {
 			case <-newBlockSignal:
@@ -125,9 +140,11 @@ func (eis *EVMIndexerService) OnStart() error {
 			continue
 		}
 		for i := lastIndexedBlock + 1; i <= latestBlock; i++ {
+			start := time.Now()
 			block, err := eis.client.Block(ctx, &i)
 			if err != nil {
 				if !isIndexerMarkedReady && markFailedToIndexBlock(i) {
+					eis.markBlockSkipped(i)
 					lastIndexedBlock = i
 				}
 				eis.Logger.Error("failed to fetch block", "height", i, "err", err)
@@ -136,6 +153,7 @@ func (eis *EVMIndexerService) OnStart() error {
 			blockResult, err := eis.client.BlockResults(ctx, &i)
 			if err != nil {
 				if !isIndexerMarkedReady && markFailedToIndexBlock(i) {
+					eis.markBlockSkipped(i)
 					lastIndexedBlock = i
 				}
 				eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
@@ -143,12 +161,60 @@ func (eis *EVMIndexerService) OnStart() error {
 			}
 			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
+				eis.markBlockSkipped(i)
 			} else if !isIndexerMarkedReady {
 				delete(startupIndexBlockFailureTracker, i)
 
 				eis.Logger.Info("indexed block", "height", i)
 			}
+			telemetry.MeasureSince(start, metricsKeyIndexer, "index_block")
 			lastIndexedBlock = i
 		}
 	}
 }
+
+// markBlockSkipped persists the block the indexer failed to index so it's retried later.
+func (eis *EVMIndexerService) markBlockSkipped(height int64) {
+	if err := eis.txIdxr.MarkBlockSkipped(height); err != nil {
+		eis.Logger.Error("failed to mark block skipped", "height", height, "err", err)
+	}
+}
+
+// retrySkippedBlocks indexes again the blocks the indexer failed to index, the blocks stay
+// marked as skipped until they're indexed.
+func (eis *EVMIndexerService) retrySkippedBlocks(ctx context.Context) {
+	heights, err := eis.txIdxr.SkippedBlocks()
+	if err != nil {
+		eis.Logger.Error("failed to load skipped blocks", "err", err)
+		return
+	}
+
+	var indexed int
+	for _, height := range heights {
+		height := height
+		block, err := eis.client.Block(ctx, &height)
+		if err != nil {
+			eis.Logger.Error("failed to fetch skipped block", "height", height, "err", err)
+			continue
+		}
+		blockResult, err := eis.client.BlockResults(ctx, &height)
+		if err != nil {
+			eis.Logger.Error("failed to fetch skipped block result", "height", height, "err", err)
+			continue
+		}
+		if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+			eis.Logger.Error("failed to index skipped block", "height", height, "err", err)
+			continue
+		}
+		eis.Logger.Info("indexed skipped block", "height", height)
+		indexed++
+	}
+
+	telemetry.SetGauge(float32(len(heights)-indexed), metricsKeyIndexer, "skipped_blocks")
+}
+
+// setIndexerMetrics sets the last indexed height of the indexer and its lag behind the chain.
+func setIndexerMetrics(lastIndexedBlock, latestBlock int64) {
+	telemetry.SetGauge(float32(lastIndexedBlock), metricsKeyIndexer, "last_indexed_height")
+	telemetry.SetGauge(float32(latestBlock-lastIndexedBlock), metricsKeyIndexer, "lag")
+}
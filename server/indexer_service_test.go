@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
+)
+
+func TestRetrySkippedBlocks(t *testing.T) {
+	height := func(h int64) interface{} {
+		return mock.MatchedBy(func(p *int64) bool { return p != nil && *p == h })
+	}
+	newBlock := func(h int64) *coretypes.ResultBlock {
+		return &coretypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: h}}}
+	}
+	results := []*abci.ResponseDeliverTx{{Code: 0}}
+
+	client := mocks.NewClient(t)
+	indexer := mocks.NewEVMTxIndexer(t)
+	indexer.On("SkippedBlocks").Return([]int64{2, 3, 4, 5}, nil)
+
+	// block 2 is indexed
+	block2 := newBlock(2)
+	client.On("Block", mock.Anything, height(2)).Return(block2, nil)
+	client.On("BlockResults", mock.Anything, height(2)).Return(&coretypes.ResultBlockResults{TxsResults: results}, nil)
+	indexer.On("IndexBlock", block2.Block, results).Return(nil).Once()
+
+	// block 3 is still unavailable
+	client.On("Block", mock.Anything, height(3)).Return(nil, errors.New("block not found"))
+
+	// the results of block 4 are still unavailable
+	client.On("Block", mock.Anything, height(4)).Return(newBlock(4), nil)
+	client.On("BlockResults", mock.Anything, height(4)).Return(nil, errors.New("results not found"))
+
+	// block 5 fails to be indexed again
+	block5 := newBlock(5)
+	client.On("Block", mock.Anything, height(5)).Return(block5, nil)
+	client.On("BlockResults", mock.Anything, height(5)).Return(&coretypes.ResultBlockResults{TxsResults: results}, nil)
+	indexer.On("IndexBlock", block5.Block, results).Return(errors.New("failed to index")).Once()
+
+	eis := NewEVMIndexerService(indexer, client)
+	eis.retrySkippedBlocks(context.Background())
+
+	indexer.AssertNumberOfCalls(t, "IndexBlock", 2)
+	client.AssertNumberOfCalls(t, "Block", 4)
+}
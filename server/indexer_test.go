@@ -0,0 +1,26 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/require"
+
+	"github.com/servprotocolorg/serv/v12/server/config"
+)
+
+func TestOpenEVMIndexerSQLite(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.IndexerBackend = config.IndexerBackendSQLite
+	cfg.IndexerDSN = filepath.Join(t.TempDir(), "evmindexer.db")
+
+	idxer, err := OpenEVMIndexer(server.NewDefaultContext(), *cfg, log.NewNopLogger(), client.Context{})
+	require.NoError(t, err)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/libs/log"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// StartIPC serves the JSON-RPC server over the unix domain socket of the endpoint, it removes
+// the socket left over by a previous run. The returned listener stops the IPC server once closed.
+func StartIPC(logger log.Logger, rpcServer *ethrpc.Server, endpoint string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(endpoint), 0o750); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(endpoint); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	ln, err := net.Listen("unix", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(endpoint, 0o600); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+
+	go func() {
+		logger.Info("Starting JSON-RPC IPC server", "path", endpoint)
+		if err := rpcServer.ServeListener(ln); err != nil {
+			logger.Debug("JSON-RPC IPC server stopped", "error", err.Error())
+		}
+	}()
+	return ln, nil
+}
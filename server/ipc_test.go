@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testIPCService struct{}
+
+func (testIPCService) Echo(s string) string { return s }
+
+func TestStartIPC(t *testing.T) {
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", testIPCService{}))
+
+	endpoint := filepath.Join(t.TempDir(), "data", "serv.ipc")
+	// a socket left over by a previous run is replaced
+	require.NoError(t, os.MkdirAll(filepath.Dir(endpoint), 0o750))
+	require.NoError(t, os.WriteFile(endpoint, nil, 0o600))
+
+	ln, err := StartIPC(log.NewNopLogger(), rpcServer, endpoint)
+	require.NoError(t, err)
+
+	info, err := os.Stat(endpoint)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	client, err := ethrpc.DialIPC(context.Background(), endpoint)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var res string
+	require.NoError(t, client.Call(&res, "test_echo", "hello"))
+	require.Equal(t, "hello", res)
+
+	// closing the listener removes the socket
+	require.NoError(t, ln.Close())
+	_, err = os.Stat(endpoint)
+	require.True(t, os.IsNotExist(err))
+}
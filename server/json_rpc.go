@@ -2,12 +2,17 @@ package server
 
 import (
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 
 	"github.com/servprotocolorg/serv/v12/rpc"
+	"github.com/servprotocolorg/serv/v12/rpc/namespaces/ethereum/admin"
+	// register the Parity style trace namespace
+	_ "github.com/servprotocolorg/serv/v12/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
@@ -18,13 +23,15 @@ import (
 	evertypes "github.com/servprotocolorg/serv/v12/types"
 )
 
-// StartJSONRPC starts the JSON-RPC server
+// StartJSONRPC starts the JSON-RPC server, the p2p switch of the admin namespace is nil if the
+// CometBFT node doesn't run in process.
 func StartJSONRPC(ctx *server.Context,
 	clientCtx client.Context,
 	tmRPCAddr,
 	tmEndpoint string,
 	config *config.Config,
 	indexer evertypes.EVMTxIndexer,
+	sw admin.Switch,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -43,10 +50,45 @@ func StartJSONRPC(ctx *server.Context,
 
 	rpcServer := ethrpc.NewServer()
 
+	var auth *rpc.JWTAuth
+	if len(config.JSONRPC.AuthAPI) > 0 {
+		secret, err := rpc.LoadOrGenerateJWTSecret(homePath(ctx, config.JSONRPC.JWTSecret))
+		if err != nil {
+			ctx.Logger.Error("failed to load the JSON-RPC JWT secret", "error", err.Error())
+			return nil, nil, err
+		}
+		auth = rpc.NewJWTAuth(secret, config.JSONRPC.AuthAPI)
+	}
+
+	limiter, err := rpc.NewRequestLimiter(config.JSONRPC, auth)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// allocate separate WS connection to Tendermint
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger), config, limiter)
+
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
-	rpcAPIArr := config.JSONRPC.API
+	rpcAPIArr := make([]string, 0, len(config.JSONRPC.API))
+	enableAdmin := false
+	for _, ns := range config.JSONRPC.API {
+		// the admin namespace depends on the node and the websocket server
+		if ns == admin.Namespace {
+			enableAdmin = true
+			continue
+		}
+		rpcAPIArr = append(rpcAPIArr, ns)
+	}
 
 	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	if enableAdmin {
+		apis = append(apis, ethrpc.API{
+			Namespace: admin.Namespace,
+			Version:   "1.0",
+			Service:   admin.NewAPI(ctx.Logger, clientCtx, sw, wsSrv),
+			Public:    false,
+		})
+	}
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -59,17 +101,9 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
-	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
-
-	handlerWithCors := cors.Default()
-	if config.API.EnableUnsafeCORS {
-		handlerWithCors = cors.AllowAll()
-	}
-
 	httpSrv := &http.Server{
 		Addr:              config.JSONRPC.Address,
-		Handler:           handlerWithCors.Handler(r),
+		Handler:           newJSONRPCHandler(config, rpcServer, limiter, wsSrv, NewReadinessHandler(indexer, clientCtx.Client)),
 		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
 		ReadTimeout:       config.JSONRPC.HTTPTimeout,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
@@ -103,11 +137,56 @@ func StartJSONRPC(ctx *server.Context,
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
-	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress, "shared-port", config.JSONRPC.SharedPort())
+	if err := wsSrv.Start(); err != nil {
+		ctx.Logger.Error("failed to start JSON WebSocket server", "error", err.Error())
+		return nil, nil, err
+	}
 
-	// allocate separate WS connection to Tendermint
-	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
-	wsSrv.Start()
+	if config.JSONRPC.IPCPath != "" {
+		ipcLn, err := StartIPC(ctx.Logger, rpcServer, homePath(ctx, config.JSONRPC.IPCPath))
+		if err != nil {
+			ctx.Logger.Error("failed to start JSON-RPC IPC server", "error", err.Error())
+			return nil, nil, err
+		}
+		// the IPC server stops with the JSON-RPC server
+		httpSrv.RegisterOnShutdown(func() {
+			_ = ipcLn.Close()
+		})
+	}
 	return httpSrv, httpSrvDone, nil
 }
+
+// newJSONRPCHandler returns the handler of the JSON-RPC server listener, it also serves the
+// websocket upgrades if the websocket server shares the listener.
+func newJSONRPCHandler(
+	config *config.Config,
+	rpcServer http.Handler,
+	limiter *rpc.RequestLimiter,
+	wsSrv rpc.WebsocketsServer,
+	readiness http.Handler,
+) http.Handler {
+	r := mux.NewRouter()
+	if config.JSONRPC.SharedPort() {
+		// serve the websocket upgrades on the JSON-RPC server listener
+		r.Handle("/", wsSrv).MatcherFunc(func(r *http.Request, _ *mux.RouteMatch) bool {
+			return websocket.IsWebSocketUpgrade(r)
+		})
+	}
+	r.Handle("/", limiter.Handler(rpcServer)).Methods("POST")
+	r.Handle(ReadinessPath, readiness).Methods("GET")
+
+	handlerWithCors := cors.Default()
+	if config.API.EnableUnsafeCORS {
+		handlerWithCors = cors.AllowAll()
+	}
+	return handlerWithCors.Handler(r)
+}
+
+// homePath returns the path relative to the node home directory if it's not absolute.
+func homePath(ctx *server.Context, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(ctx.Config.RootDir, path)
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/servprotocolorg/serv/v12/rpc"
+	"github.com/servprotocolorg/serv/v12/server/config"
+)
+
+type echoService struct{}
+
+func (echoService) Echo(s string) string { return s }
+
+func TestJSONRPCHandlerSharedPort(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+
+	// the addresses are equivalent but not equal
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.Address = "127.0.0.1:" + port
+	cfg.JSONRPC.WsAddress = "[::ffff:127.0.0.1]:" + port
+	require.True(t, cfg.JSONRPC.SharedPort())
+
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", echoService{}))
+	limiter, err := rpc.NewRequestLimiter(cfg.JSONRPC, nil)
+	require.NoError(t, err)
+
+	// the CometBFT websocket client isn't started, the subscriptions aren't tested
+	tmWSClient, err := rpcclient.NewWS("tcp://127.0.0.1:26657", "/websocket")
+	require.NoError(t, err)
+	wsSrv := rpc.NewWebsocketsServer(client.Context{}, log.NewNopLogger(), tmWSClient, cfg, limiter)
+	require.NoError(t, wsSrv.Start())
+
+	srv := &http.Server{
+		Handler:           newJSONRPCHandler(cfg, rpcServer, limiter, wsSrv, http.NotFoundHandler()),
+		ReadHeaderTimeout: cfg.JSONRPC.HTTPTimeout,
+	}
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(func() {
+		_ = wsSrv.Stop()
+		_ = srv.Close()
+	})
+
+	req := map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "test_echo", "params": []string{"hello"}}
+	var res struct {
+		Result string `json:"result"`
+	}
+
+	// HTTP request
+	bz, err := json.Marshal(req)
+	require.NoError(t, err)
+	httpRes, err := http.Post("http://127.0.0.1:"+port, "application/json", bytes.NewReader(bz))
+	require.NoError(t, err)
+	require.NoError(t, json.NewDecoder(httpRes.Body).Decode(&res))
+	require.NoError(t, httpRes.Body.Close())
+	require.Equal(t, "hello", res.Result)
+
+	// websocket upgrade on the same listener
+	conn, _, err := websocket.DefaultDialer.Dial("ws://127.0.0.1:"+port, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	res.Result = ""
+	require.NoError(t, conn.WriteJSON(req))
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, "hello", res.Result)
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/servprotocolorg/serv/v12/server/config"
+	evertypes "github.com/servprotocolorg/serv/v12/types"
+)
+
+const (
+	// ReadinessPath is the path of the JSON-RPC server readiness probe.
+	ReadinessPath = "/ready"
+
+	// ReadinessMaxLag is the maximum number of blocks the eth tx indexer can lag behind the
+	// chain for the node to be ready.
+	ReadinessMaxLag = 5
+)
+
+// ReadinessResponse is the body of the readiness probe response.
+type ReadinessResponse struct {
+	Ready            bool  `json:"ready"`
+	IndexerEnabled   bool  `json:"indexerEnabled"`
+	LatestBlock      int64 `json:"latestBlock"`
+	LastIndexedBlock int64 `json:"lastIndexedBlock"`
+	Lag              int64 `json:"lag"`
+	SkippedBlocks    int   `json:"skippedBlocks"`
+}
+
+// NewReadinessHandler returns the handler of the readiness probe, it responds with
+// 503 Service Unavailable while the eth tx indexer lags more than ReadinessMaxLag blocks behind
+// the chain, so the load balancers stop routing the requests to the node. The node is always
+// ready if the indexer is disabled.
+func NewReadinessHandler(indexer evertypes.EVMTxIndexer, client tmrpcclient.StatusClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res := ReadinessResponse{Ready: true, LatestBlock: -1, LastIndexedBlock: -1}
+		if indexer != nil {
+			res.IndexerEnabled = true
+			res.Ready = readinessLag(r.Context(), indexer, client, &res)
+
+			if skipped, err := indexer.SkippedBlocks(); err == nil {
+				res.SkippedBlocks = len(skipped)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !res.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// readinessLag fills the heights and the lag of the response, it returns true if the indexer
+// completed its initial sync and its current lag is within ReadinessMaxLag.
+func readinessLag(
+	ctx context.Context,
+	indexer evertypes.EVMTxIndexer,
+	client tmrpcclient.StatusClient,
+	res *ReadinessResponse,
+) bool {
+	lastIndexed, err := indexer.GetLastRequestIndexedBlock()
+	if err != nil {
+		return false
+	}
+	res.LastIndexedBlock = lastIndexed
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return false
+	}
+	res.LatestBlock = status.SyncInfo.LatestBlockHeight
+	res.Lag = res.LatestBlock - res.LastIndexedBlock
+	if res.Lag < 0 {
+		res.Lag = 0
+	}
+	return indexer.IsReady() && res.Lag <= ReadinessMaxLag
+}
+
+// StartReadinessServer serves only the readiness probe on the JSON-RPC server address, it's used
+// while the eth tx indexer catches up with the chain before the JSON-RPC server starts.
+func StartReadinessServer(ctx *server.Context, config *config.Config, handler http.Handler) (*http.Server, error) {
+	mux := http.NewServeMux()
+	mux.Handle(ReadinessPath, handler)
+
+	srv := &http.Server{
+		Addr:              config.JSONRPC.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+	}
+	ln, err := Listen(srv.Addr, config)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error)
+	go func() {
+		ctx.Logger.Info("Starting readiness probe server", "address", config.JSONRPC.Address)
+		if err := srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("failed to start readiness probe server", "error", err.Error())
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(types.ServerStartTime): // assume the server started successfully
+	}
+	return srv, nil
+}
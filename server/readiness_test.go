@@ -0,0 +1,70 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/servprotocolorg/serv/v12/rpc/backend/mocks"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	testCases := []struct {
+		name        string
+		indexerOff  bool
+		ready       bool
+		lastIndexed int64
+		latest      int64
+		statusErr   error
+		expCode     int
+		expLag      int64
+	}{
+		{"indexer disabled", true, false, 0, 0, nil, http.StatusOK, 0},
+		{"caught up", false, true, 100, 100, nil, http.StatusOK, 0},
+		{"lag within the limit", false, true, 100, 100 + ReadinessMaxLag, nil, http.StatusOK, ReadinessMaxLag},
+		{"lag above the limit", false, true, 100, 101 + ReadinessMaxLag, nil, http.StatusServiceUnavailable, ReadinessMaxLag + 1},
+		{"initial sync in progress", false, false, 100, 100, nil, http.StatusServiceUnavailable, 0},
+		{"status unavailable", false, true, 100, 100, errors.New("unavailable"), http.StatusServiceUnavailable, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mocks.NewClient(t)
+			var handler http.HandlerFunc
+			if tc.indexerOff {
+				handler = NewReadinessHandler(nil, client)
+			} else {
+				indexer := mocks.NewEVMTxIndexer(t)
+				indexer.On("GetLastRequestIndexedBlock").Return(tc.lastIndexed, nil)
+				indexer.On("IsReady").Return(tc.ready).Maybe()
+				indexer.On("SkippedBlocks").Return([]int64{7}, nil)
+
+				var status *coretypes.ResultStatus
+				if tc.statusErr == nil {
+					status = &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: tc.latest}}
+				}
+				client.On("Status", mock.Anything).Return(status, tc.statusErr)
+				handler = NewReadinessHandler(indexer, client)
+			}
+
+			rec := httptest.NewRecorder()
+			handler(rec, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+			require.Equal(t, tc.expCode, rec.Code)
+
+			var res ReadinessResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+			require.Equal(t, tc.expCode == http.StatusOK, res.Ready)
+			require.Equal(t, !tc.indexerOff, res.IndexerEnabled)
+			require.Equal(t, tc.expLag, res.Lag)
+			if !tc.indexerOff {
+				require.Equal(t, 1, res.SkippedBlocks)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/servprotocolorg/serv/v12/constants"
-	"github.com/servprotocolorg/serv/v12/indexer"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"io"
 	"net"
@@ -49,6 +48,7 @@ import (
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/servprotocolorg/serv/v12/rpc/namespaces/ethereum/admin"
 	ethdebug "github.com/servprotocolorg/serv/v12/rpc/namespaces/ethereum/debug"
 	"github.com/servprotocolorg/serv/v12/server/config"
 	srvflags "github.com/servprotocolorg/serv/v12/server/flags"
@@ -183,7 +183,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCEnable, true, "Define if the JSON-RPC server should be enabled")
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
-	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on, the same address as the JSON-RPC server shares its port")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the JSON-RPC IPC server unix domain socket path, relative to the home directory if not absolute (empty=disabled)")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, fmt.Sprintf("Sets a cap on gas that can be used in eth_call/estimateGas unit is %s (0=infinite)", constants.BaseDenom)) //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs")                                                 //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
@@ -195,6 +196,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.DefaultIndexerBackend, "Sets the database of the eth tx indexer (kv|postgres|sqlite)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "Sets the data source name of the SQL database of the eth tx indexer")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the max number of requests in a batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, config.DefaultBatchResponseMaxSize, "Sets the max size in bytes of a batch response (0=unlimited)")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitPerIP, config.DefaultRateLimitPerIP, "Sets the number of requests per second allowed per client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the number of requests a client IP can burst above the rate limits")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodRateLimits, nil, "Sets the per client IP rate limits of methods, formatted as method=requests-per-second[:burst]")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, nil, "Defines the only JSON-RPC methods allowed if not empty, namespace_* matches all the methods of the namespace")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, nil, "Defines the JSON-RPC methods rejected, namespace_* matches all the methods of the namespace")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, nil, "Defines a list of JSON-RPC namespaces that require the JWT authentication")
+	cmd.Flags().String(srvflags.JSONRPCJWTSecret, config.DefaultJWTSecretPath, "the hex encoded JWT secret file of the authenticated JSON-RPC namespaces, relative to the home directory if not absolute, generated if missing")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -511,14 +523,12 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 	if config.JSONRPC.Enable {
 		// Start EVMTxIndexer service
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		evmTxIndexer, err := OpenEVMIndexer(ctx, config.JSONRPC, idxLogger, clientCtx)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			logger.Error("failed to open evm indexer", "backend", config.JSONRPC.IndexerBackend, "error", err.Error())
 			return err
 		}
-
-		idxLogger := ctx.Logger.With("indexer", "evm")
-		evmTxIndexer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(evmTxIndexer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(idxLogger)
 
@@ -535,14 +545,28 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		case <-time.After(types.ServerStartTime): // assume server started successfully
 		}
 
-		// waiting for indexer indexes blocks
-		for {
-			time.Sleep(1 * time.Second)
-			if evmTxIndexer.IsReady() {
-				break
+		// waiting for indexer indexes blocks, the readiness probe is served meanwhile
+		if !evmTxIndexer.IsReady() {
+			readinessSrv, err := StartReadinessServer(
+				ctx, &config, NewReadinessHandler(evmTxIndexer, clientCtx.Client),
+			)
+			if err != nil {
+				return err
+			}
+
+			for {
+				time.Sleep(1 * time.Second)
+				if evmTxIndexer.IsReady() {
+					break
+				}
+
+				logger.Info("indexer still in progress, keep waiting")
 			}
 
-			logger.Info("indexer still in progress, keep waiting")
+			// release the listener for the JSON-RPC server
+			if err := readinessSrv.Close(); err != nil {
+				logger.Error("failed to close the readiness probe server", "error", err.Error())
+			}
 		}
 
 		// Start Json-RPC server
@@ -555,7 +579,11 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, evmTxIndexer)
+		var sw admin.Switch
+		if tmNode != nil {
+			sw = tmNode.Switch()
+		}
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, evmTxIndexer, sw)
 		if err != nil {
 			return err
 		}
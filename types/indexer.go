@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of the custom ETH-Tx indexer.
@@ -32,4 +33,23 @@ type EVMTxIndexer interface {
 
 	// GetLastRequestIndexedBlock returns the block height of the latest success called to IndexBlock()
 	GetLastRequestIndexedBlock() (int64, error)
+
+	// MarkBlockSkipped persists a block the indexer failed to index so it's retried later,
+	// the mark is cleared when the block is indexed.
+	MarkBlockSkipped(int64) error
+
+	// SkippedBlocks returns the blocks marked as skipped in ascending order.
+	SkippedBlocks() ([]int64, error)
+}
+
+// EVMLogIndexer defines the interface of an ETH-Tx indexer which also indexes the logs, it's
+// optionally implemented by the EVMTxIndexer.
+type EVMLogIndexer interface {
+	// LogIndexedRange returns the first and last blocks of the contiguous range whose logs are indexed.
+	// Returns -1, -1 if no logs are indexed.
+	LogIndexedRange() (int64, int64, error)
+
+	// GetLogs returns the logs of the block range matching the addresses and positional topics criteria,
+	// fails if more than limit logs match.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
 }
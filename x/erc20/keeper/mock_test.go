@@ -10,8 +10,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/stretchr/testify/mock"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 var _ types.EVMKeeper = &MockEVMKeeper{}
@@ -11,8 +11,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/vm"
 
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 )
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetContractPermissionsCmd(),
 	)
 	return cmd
 }
@@ -132,3 +133,31 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetContractPermissionsCmd queries the deployer allowlist and the frozen contracts
+func GetContractPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-permissions",
+		Short: "Get the evm deployer allowlist and frozen contracts",
+		Long:  "Get the addresses allowed to deploy contracts and the addresses of the frozen contracts.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractPermissions(cmd.Context(), &types.QueryContractPermissionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
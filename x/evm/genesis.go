@@ -29,6 +29,9 @@ func InitGenesis(
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	k.SetDeployerAllowlist(ctx, types.HexToAddresses(data.DeployerAllowlist))
+	k.SetFrozenContracts(ctx, types.HexToAddresses(data.FrozenContracts))
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -68,6 +71,10 @@ func InitGenesis(
 		}
 	}
 
+	if err := k.AddPreinstalls(ctx, data.Preinstalls); err != nil {
+		panic(fmt.Errorf("error adding preinstalls %s", err))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -96,7 +103,9 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:          ethGenAccounts,
+		Params:            k.GetParams(ctx),
+		DeployerAllowlist: types.AddressesToHex(k.GetDeployerAllowlist(ctx)),
+		FrozenContracts:   types.AddressesToHex(k.GetFrozenContracts(ctx)),
 	}
 }
@@ -21,6 +21,10 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 
 	var vmdb *statedb.StateDB
 
+	// the suite is set up with the default genesis, which already added the default preinstalls
+	defaultGenState := types.DefaultGenesisState()
+	defaultGenState.Preinstalls = nil
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -30,9 +34,29 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 		{
 			"default",
 			func() {},
-			types.DefaultGenesisState(),
+			defaultGenState,
 			false,
 		},
+		{
+			"valid preinstall",
+			func() {},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Preinstalls: []types.Preinstall{
+					{Name: "test", Address: address.String(), Code: "0x600160005260206000f3"},
+				},
+			},
+			false,
+		},
+		{
+			"preinstall at an account in use",
+			func() {},
+			&types.GenesisState{
+				Params:      types.DefaultParams(),
+				Preinstalls: types.DefaultPreinstalls,
+			},
+			true,
+		},
 		{
 			"valid account",
 			func() {
@@ -38,6 +38,7 @@ import (
 	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
 	evertypes "github.com/servprotocolorg/serv/v12/types"
 	"github.com/servprotocolorg/serv/v12/x/evm"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 
@@ -679,7 +680,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 
 			txData, err := types.UnpackTxData(tx.Data)
 			suite.Require().NoError(err)
-			fees, err := keeper.VerifyFee(txData, types.DefaultEVMDenom, baseFee, true, true, suite.ctx.IsCheckTx())
+			fees, err := keeper.VerifyFee(txData, types.DefaultEVMDenom, baseFee, true, true, false, suite.ctx.IsCheckTx())
 			suite.Require().NoError(err)
 			err = k.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From))
 			suite.Require().NoError(err)
@@ -710,7 +711,11 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 }
 
 func (suite *EvmTestSuite) TestContractDeploymentRevert() {
-	intrinsicGas := uint64(134180)
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.from, big.NewInt(0))
+	suite.Require().NoError(err)
+	input := append(types.ERC20Contract.Bin, ctorArgs...)
+	// includes the shanghai initcode gas
+	intrinsicGas := uint64(134180) + vm.InitCodeWordGas*uint64((len(input)+31)/32)
 	testCases := []struct {
 		msg      string
 		gasLimit uint64
@@ -737,13 +742,10 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 			k.SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.ctx, suite.from)
-			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.from, big.NewInt(0))
-			suite.Require().NoError(err)
-
 			ethTxParams := &types.EvmTxArgs{
 				Nonce:    nonce,
 				GasLimit: tc.gasLimit,
-				Input:    append(types.ERC20Contract.Bin, ctorArgs...),
+				Input:    input,
 			}
 			tx := types.NewTx(ethTxParams)
 			suite.SignTx(tx)
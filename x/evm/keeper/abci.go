@@ -8,14 +8,16 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper and stores the hash of the
+// previous block in the block hash ring buffer.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+	k.TrackBlockHash(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore. It emits the randomness of the block and sets the randomness of the next block. The EVM
+// end block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
@@ -23,5 +25,9 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	random := k.GetBlockRandom(infCtx)
+	k.EmitBlockRandomEvent(infCtx, random)
+	k.SetBlockRandom(infCtx, NextBlockRandom(random, ctx.BlockHeader()))
+
 	return []abci.ValidatorUpdate{}
 }
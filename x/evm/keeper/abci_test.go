@@ -1,18 +1,75 @@
 package keeper_test
 
 import (
+	"github.com/servprotocolorg/serv/v12/x/evm/keeper"
 	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
 	"github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+func (suite *KeeperTestSuite) TestBeginBlock() {
+	testCases := []struct {
+		name      string
+		active    bool
+		height    int64
+		expPruned bool
+	}{
+		{"first block", false, 1, false},
+		{"within the window", false, evmtypes.BlockHashHistorySize, false},
+		{"out of the window", false, evmtypes.BlockHashHistorySize + 2, true},
+		{"out of the window, history contract active", true, evmtypes.BlockHashHistorySize + 2, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			k := suite.app.EvmKeeper
+
+			params := k.GetParams(suite.ctx)
+			if tc.active {
+				params.ActivePrecompiles = append(params.ActivePrecompiles, evmtypes.BlockHashHistoryAddress.Hex())
+			}
+			suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+			oldest := uint64(1)
+			k.SetBlockHash(suite.ctx, oldest, common.HexToHash("0x01"))
+
+			parentHash := common.HexToHash("0x02")
+			header := suite.ctx.BlockHeader()
+			header.Height = tc.height
+			header.LastBlockId.Hash = parentHash.Bytes()
+			ctx := suite.ctx.WithBlockHeader(header)
+
+			k.BeginBlock(ctx, types.RequestBeginBlock{})
+
+			parent, found := k.GetBlockHash(ctx, uint64(tc.height-1))
+			suite.Require().Equal(tc.height > 1, found)
+			if found {
+				suite.Require().Equal(parentHash, parent)
+			}
+			_, found = k.GetBlockHash(ctx, oldest)
+			suite.Require().Equal(!tc.expPruned, found)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEndBlock() {
 	em := suite.ctx.EventManager()
 	suite.Require().Equal(0, len(em.Events()))
 
+	random := common.HexToHash("0x01")
+	suite.app.EvmKeeper.SetBlockRandom(suite.ctx, random)
+
 	res := suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
 	suite.Require().Equal([]types.ValidatorUpdate{}, res)
 
-	// should emit 1 EventTypeBlockBloom event on EndBlock
-	suite.Require().Equal(1, len(em.Events()))
+	// should emit 1 EventTypeBlockBloom event and 1 EventTypeBlockRandom event on EndBlock
+	suite.Require().Equal(2, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
+	suite.Require().Equal(evmtypes.EventTypeBlockRandom, em.Events()[1].Type)
+	suite.Require().Equal(random.Hex(), em.Events()[1].Attributes[0].Value)
+
+	// the randomness of the next block is derived from the randomness and the header of the block
+	next := suite.app.EvmKeeper.GetBlockRandom(suite.ctx)
+	suite.Require().Equal(keeper.NextBlockRandom(random, suite.ctx.BlockHeader()), next)
+	suite.Require().NotEqual(random, next)
 }
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// The evm module keeps the hashes of the last blocks in a ring buffer of the evm store, it's
+// written at the beginning of each block with the hash of the previous block and read by the
+// BLOCKHASH opcode. The buffer holds the last types.BlockHashHistorySize hashes, or the last
+// types.BlockHashHistoryServeWindow hashes while the block hash history contract is active.
+// Each slot stores the height along with the hash so that a stale slot isn't mistaken for the
+// requested height.
+
+// TrackBlockHash stores the hash of the previous block in the block hash ring buffer and removes
+// the hash falling out of the window.
+func (k Keeper) TrackBlockHash(ctx sdk.Context) {
+	header := ctx.BlockHeader()
+	if header.Height <= 1 || len(header.LastBlockId.Hash) == 0 {
+		return
+	}
+
+	height := uint64(header.Height - 1) // #nosec G701 -- checked for non positive heights above
+	k.SetBlockHash(ctx, height, common.BytesToHash(header.LastBlockId.Hash))
+
+	// the slots of the serve window are overwritten as the ring buffer wraps around
+	if k.GetParams(ctx).IsActivePrecompile(types.BlockHashHistoryAddress) {
+		return
+	}
+	if height > types.BlockHashHistorySize {
+		k.DeleteBlockHash(ctx, height-types.BlockHashHistorySize)
+	}
+}
+
+// GetBlockHash returns the hash of the block at the given height from the block hash ring
+// buffer, it returns false if the hash isn't in the buffer.
+func (k Keeper) GetBlockHash(ctx sdk.Context, height uint64) (common.Hash, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockHashKey(height))
+	if len(bz) != 8+common.HashLength || sdk.BigEndianToUint64(bz[:8]) != height {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz[8:]), true
+}
+
+// SetBlockHash stores the hash of the block at the given height in the block hash ring buffer.
+func (k Keeper) SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockHashKey(height), append(sdk.Uint64ToBigEndian(height), hash.Bytes()...))
+}
+
+// DeleteBlockHash removes the hash of the block at the given height from the block hash ring
+// buffer.
+func (k Keeper) DeleteBlockHash(ctx sdk.Context, height uint64) {
+	if _, found := k.GetBlockHash(ctx, height); !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BlockHashKey(height))
+}
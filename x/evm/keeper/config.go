@@ -9,7 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 // EVMConfig creates the EVMConfig based on current state
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	errorsmod "cosmossdk.io/errors"
@@ -12,6 +14,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 )
 
@@ -20,8 +23,31 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
+	shanghai := cfg.IsShanghai(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	return IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul, shanghai)
+}
+
+// IntrinsicGas returns the intrinsic gas of the transaction data. Once shanghai is activated, the
+// EIP-3860 initcode gas is added to the gas of the contract creations and the initcode larger than
+// vm.MaxInitCodeSize is rejected.
+func IntrinsicGas(data []byte, accessList ethtypes.AccessList, isContractCreation, homestead, istanbul, shanghai bool) (uint64, error) {
+	gas, err := core.IntrinsicGas(data, accessList, isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+	if !isContractCreation || !shanghai {
+		return gas, nil
+	}
+
+	if len(data) > vm.MaxInitCodeSize {
+		return 0, errorsmod.Wrapf(vm.ErrMaxInitCodeSizeExceeded, "initcode size %d, limit %d", len(data), vm.MaxInitCodeSize)
+	}
+	initCodeGas := vm.InitCodeWordGas * ((uint64(len(data)) + 31) / 32)
+	if gas > math.MaxUint64-initCodeGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + initCodeGas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -4,19 +4,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
-	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/servprotocolorg/serv/v12/server/config"
 	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+	ethlogger "github.com/servprotocolorg/serv/v12/x/evm/core/tracers/logger"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 )
@@ -576,7 +577,8 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				}
 			},
 			true,
-			1186778,
+			// includes the shanghai initcode gas of the 165 words of the initcode
+			1187108,
 			false,
 		},
 		// estimate gas of an erc20 transfer, the exact gas number is checked with geth
@@ -653,7 +655,8 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				}
 			},
 			true,
-			1186778,
+			// includes the shanghai initcode gas of the 165 words of the initcode
+			1187108,
 			true,
 		},
 		{
@@ -982,6 +985,130 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxNativeTracers() {
+	type callFrame struct {
+		Type  string `json:"type"`
+		To    string `json:"to"`
+		Calls []json.RawMessage
+		Logs  []struct {
+			Address common.Address `json:"address"`
+			Topics  []common.Hash  `json:"topics"`
+		} `json:"logs"`
+	}
+
+	testCases := []struct {
+		msg         string
+		traceConfig *types.TraceConfig
+		expPass     bool
+		check       func(contractAddr common.Address, data []byte)
+	}{
+		{
+			msg:         "call tracer",
+			traceConfig: &types.TraceConfig{Tracer: types.TracerCall},
+			expPass:     true,
+			check: func(contractAddr common.Address, data []byte) {
+				var frame callFrame
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Equal("CALL", frame.Type)
+				suite.Require().Equal(strings.ToLower(contractAddr.Hex()), frame.To)
+				suite.Require().Empty(frame.Logs)
+			},
+		},
+		{
+			msg:         "call tracer with logs",
+			traceConfig: &types.TraceConfig{Tracer: types.TracerCall, TracerJsonConfig: `{"withLog":true,"onlyTopCall":true}`},
+			expPass:     true,
+			check: func(contractAddr common.Address, data []byte) {
+				var frame callFrame
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Len(frame.Logs, 1)
+				suite.Require().Equal(contractAddr, frame.Logs[0].Address)
+				// Transfer(address,address,uint256)
+				suite.Require().Len(frame.Logs[0].Topics, 3)
+				suite.Require().Equal(crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), frame.Logs[0].Topics[0])
+			},
+		},
+		{
+			msg:         "prestate tracer",
+			traceConfig: &types.TraceConfig{Tracer: types.TracerPrestate},
+			expPass:     true,
+			check: func(contractAddr common.Address, data []byte) {
+				var prestate map[common.Address]json.RawMessage
+				suite.Require().NoError(json.Unmarshal(data, &prestate))
+				suite.Require().Contains(prestate, suite.address)
+				suite.Require().Contains(prestate, contractAddr)
+			},
+		},
+		{
+			msg:         "prestate tracer in diff mode",
+			traceConfig: &types.TraceConfig{Tracer: types.TracerPrestate, TracerJsonConfig: `{"diffMode":true}`},
+			expPass:     true,
+			check: func(contractAddr common.Address, data []byte) {
+				var diff struct {
+					Pre  map[common.Address]json.RawMessage `json:"pre"`
+					Post map[common.Address]json.RawMessage `json:"post"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &diff))
+				suite.Require().Contains(diff.Pre, contractAddr)
+				suite.Require().Contains(diff.Post, contractAddr)
+			},
+		},
+		{
+			msg:         "flat call tracer",
+			traceConfig: &types.TraceConfig{Tracer: types.TracerFlatCall},
+			expPass:     true,
+			check: func(contractAddr common.Address, data []byte) {
+				var traces []struct {
+					Type   string `json:"type"`
+					Action struct {
+						CallType string         `json:"callType"`
+						To       common.Address `json:"to"`
+					} `json:"action"`
+					BlockNumber     uint64      `json:"blockNumber"`
+					TraceAddress    []int       `json:"traceAddress"`
+					TransactionHash common.Hash `json:"transactionHash"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &traces))
+				suite.Require().Len(traces, 1)
+				suite.Require().Equal("call", traces[0].Type)
+				suite.Require().Equal("call", traces[0].Action.CallType)
+				suite.Require().Equal(contractAddr, traces[0].Action.To)
+				// the request has no block number, the trace runs in the context of the first block
+				suite.Require().Equal(uint64(1), traces[0].BlockNumber)
+				suite.Require().Empty(traces[0].TraceAddress)
+				suite.Require().NotEqual(common.Hash{}, traces[0].TransactionHash)
+			},
+		},
+		{
+			msg:         "invalid tracer config",
+			traceConfig: &types.TraceConfig{Tracer: types.TracerCall, TracerJsonConfig: `{"withLog":"yes"}`},
+			expPass:     false,
+		},
+
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+			suite.Commit()
+
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg:         txMsg,
+				TraceConfig: tc.traceConfig,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.check(contractAddr, res.Data)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1155,6 +1282,48 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceBlockStateDiff() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	res, err := suite.queryClient.TraceBlock(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceBlockRequest{
+		Txs: []*types.MsgEthereumTx{txMsg},
+		TraceConfig: &types.TraceConfig{
+			Tracer:           types.TracerPrestate,
+			TracerJsonConfig: `{"diffMode":true}`,
+		},
+	})
+	suite.Require().NoError(err)
+
+	type account struct {
+		Storage map[common.Hash]common.Hash `json:"storage"`
+	}
+	var results []struct {
+		Result struct {
+			Pre  map[common.Address]account `json:"pre"`
+			Post map[common.Address]account `json:"post"`
+		} `json:"result"`
+		Error string `json:"error"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().Empty(results[0].Error)
+
+	// only the token balances of the sender and the recipient are modified
+	diff := results[0].Result
+	suite.Require().Len(diff.Post, 1)
+	suite.Require().Contains(diff.Post, contractAddr)
+	suite.Require().Len(diff.Post[contractAddr].Storage, 2)
+	suite.Require().Contains(diff.Pre, contractAddr)
+	for slot, value := range diff.Pre[contractAddr].Storage {
+		suite.Require().NotEqual(value, diff.Post[contractAddr].Storage[slot])
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := utiltx.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1339,6 +1508,214 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallStateOverride() {
+	contract := utiltx.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(0))
+	value := common.BigToHash(big.NewInt(42))
+	balance := (*hexutil.Big)(big.NewInt(1000))
+
+	// SLOAD(0) and return the value
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	// SELFBALANCE and return the value
+	balanceCode := hexutil.Bytes(common.FromHex("0x4760005260206000f3"))
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expRet    common.Hash
+		expPass   bool
+	}{
+		{
+			"no overrides",
+			nil,
+			common.Hash{},
+			true,
+		},
+		{
+			"pass - override code and state",
+			types.StateOverride{
+				contract: {Code: &sloadCode, State: &map[common.Hash]common.Hash{slot: value}},
+			},
+			value,
+			true,
+		},
+		{
+			"pass - override code and state diff",
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: &map[common.Hash]common.Hash{slot: value}},
+			},
+			value,
+			true,
+		},
+		{
+			"pass - override code and balance",
+			types.StateOverride{
+				contract: {Code: &balanceCode, Balance: &balance},
+			},
+			common.BigToHash(balance.ToInt()),
+			true,
+		},
+		{
+			"fail - both state and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					State:     &map[common.Hash]common.Hash{slot: value},
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			common.Hash{},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+
+			// the overrides must not be persisted
+			suite.Require().False(suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contract).IsContract())
+
+			gasRes, err := suite.queryClient.EstimateGas(suite.ctx, req)
+			suite.Require().NoError(err)
+			suite.Require().GreaterOrEqual(gasRes.Gas, ethparams.TxGas)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	contract := utiltx.GenerateAddress()
+	number := big.NewInt(12345)
+
+	// NUMBER and return the value
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+
+	testCases := []struct {
+		msg            string
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		traceConfig    *types.TraceConfig
+		expPass        bool
+		check          func(data []byte)
+	}{
+		{
+			msg:       "default tracer with state override",
+			overrides: types.StateOverride{contract: {Code: &numberCode}},
+			expPass:   true,
+			check: func(data []byte) {
+				var res struct {
+					Failed      bool              `json:"failed"`
+					ReturnValue string            `json:"returnValue"`
+					StructLogs  []json.RawMessage `json:"structLogs"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &res))
+				suite.Require().False(res.Failed)
+				suite.Require().Len(res.StructLogs, 6)
+				suite.Require().Equal(common.BigToHash(big.NewInt(suite.ctx.BlockHeight())), common.HexToHash(res.ReturnValue))
+			},
+		},
+		{
+			msg:            "default tracer with block override",
+			overrides:      types.StateOverride{contract: {Code: &numberCode}},
+			blockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(number)},
+			expPass:        true,
+			check: func(data []byte) {
+				var res struct {
+					ReturnValue string `json:"returnValue"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &res))
+				suite.Require().Equal(common.BigToHash(number), common.HexToHash(res.ReturnValue))
+			},
+		},
+		{
+			msg:         "call tracer",
+			overrides:   types.StateOverride{contract: {Code: &numberCode}},
+			traceConfig: &types.TraceConfig{Tracer: types.TracerCall},
+			expPass:     true,
+			check: func(data []byte) {
+				var frame struct {
+					Type   string         `json:"type"`
+					To     common.Address `json:"to"`
+					Output hexutil.Bytes  `json:"output"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Equal("CALL", frame.Type)
+				suite.Require().Equal(contract, frame.To)
+				suite.Require().Equal(common.BigToHash(big.NewInt(suite.ctx.BlockHeight())), common.BytesToHash(frame.Output))
+			},
+		},
+		{
+			msg:            "invalid block override",
+			blockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(-1))},
+			expPass:        false,
+		},
+		{
+			msg: "invalid state override",
+			overrides: types.StateOverride{
+				contract: {
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				},
+			},
+			expPass: false,
+		},
+		{
+			msg:         "negative limit",
+			traceConfig: &types.TraceConfig{Limit: -1},
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+			}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.check(res.Data)
+
+			// the overrides must not be persisted
+			suite.Require().False(suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contract).IsContract())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
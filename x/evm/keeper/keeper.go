@@ -11,11 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	"math/big"
 
 	evertypes "github.com/servprotocolorg/serv/v12/types"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 )
@@ -53,6 +53,8 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+	// stateful precompiled contracts registered by the app, keyed by address
+	precompiles map[common.Address]vm.PrecompiledContract
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
@@ -105,6 +105,11 @@ func (suite *KeeperTestSuite) TestBaseFee() {
 }
 
 func (suite *KeeperTestSuite) TestGetAccountStorage() {
+	preinstalls := make(map[common.Address]bool)
+	for _, preinstall := range evmtypes.DefaultPreinstalls {
+		preinstalls[common.HexToAddress(preinstall.Address)] = true
+	}
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -138,6 +143,10 @@ func (suite *KeeperTestSuite) TestGetAccountStorage() {
 				}
 
 				addr := ethAccount.EthAddress()
+				if preinstalls[addr] {
+					// ignore the preinstalls of the default genesis
+					return false
+				}
 				storage := suite.app.EvmKeeper.GetAccountStorage(suite.ctx, addr)
 
 				suite.Require().Equal(tc.expRes[i], len(storage))
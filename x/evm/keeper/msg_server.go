@@ -137,6 +137,12 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
+	for _, addr := range req.Params.ActivePrecompileAddresses() {
+		if !k.IsPrecompileRegistered(addr) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompile, "precompile %s is not registered", addr)
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
@@ -144,3 +150,47 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateDeployerAllowlist implements the gRPC MsgServer interface. When an UpdateDeployerAllowlist
+// proposal passes, it replaces the addresses allowed to deploy contracts. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateDeployerAllowlist(goCtx context.Context, req *types.MsgUpdateDeployerAllowlist) (*types.MsgUpdateDeployerAllowlistResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDeployerAllowlist(ctx, types.HexToAddresses(req.Deployers))
+
+	return &types.MsgUpdateDeployerAllowlistResponse{}, nil
+}
+
+// UpdateFrozenContracts implements the gRPC MsgServer interface. When an UpdateFrozenContracts
+// proposal passes, it replaces the addresses of the frozen contracts. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateFrozenContracts(goCtx context.Context, req *types.MsgUpdateFrozenContracts) (*types.MsgUpdateFrozenContractsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetFrozenContracts(ctx, types.HexToAddresses(req.Contracts))
+
+	return &types.MsgUpdateFrozenContractsResponse{}, nil
+}
+
+// RegisterPreinstalls implements the gRPC MsgServer interface. When a RegisterPreinstalls proposal
+// passes, it places the contracts at their addresses. The registration can only be performed if
+// the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegisterPreinstalls) (*types.MsgRegisterPreinstallsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.AddPreinstalls(ctx, req.Preinstalls); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterPreinstallsResponse{}, nil
+}
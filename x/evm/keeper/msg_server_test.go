@@ -6,8 +6,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -109,3 +111,148 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateDeployerAllowlist() {
+	deployer := utiltx.GenerateAddress()
+	testCases := []struct {
+		name         string
+		request      *types.MsgUpdateDeployerAllowlist
+		expectErr    bool
+		expAllowlist []common.Address
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateDeployerAllowlist{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - set allowlist",
+			request: &types.MsgUpdateDeployerAllowlist{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Deployers: []string{deployer.Hex()},
+			},
+			expAllowlist: []common.Address{deployer},
+		},
+		{
+			name: "pass - clear allowlist",
+			request: &types.MsgUpdateDeployerAllowlist{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			},
+			expAllowlist: []common.Address{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.app.EvmKeeper.SetDeployerAllowlist(suite.ctx, []common.Address{suite.address})
+
+			_, err := suite.app.EvmKeeper.UpdateDeployerAllowlist(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAllowlist, suite.app.EvmKeeper.GetDeployerAllowlist(suite.ctx))
+			suite.Require().Equal(len(tc.expAllowlist) == 0, suite.app.EvmKeeper.IsDeployerAllowed(suite.ctx, suite.address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateFrozenContracts() {
+	contract := utiltx.GenerateAddress()
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateFrozenContracts
+		expectErr bool
+		expFrozen []common.Address
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateFrozenContracts{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - freeze contract",
+			request: &types.MsgUpdateFrozenContracts{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Contracts: []string{contract.Hex()},
+			},
+			expFrozen: []common.Address{contract},
+		},
+		{
+			name: "pass - unfreeze contracts",
+			request: &types.MsgUpdateFrozenContracts{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			},
+			expFrozen: []common.Address{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.app.EvmKeeper.SetFrozenContracts(suite.ctx, []common.Address{suite.address})
+
+			_, err := suite.app.EvmKeeper.UpdateFrozenContracts(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFrozen, suite.app.EvmKeeper.GetFrozenContracts(suite.ctx))
+			suite.Require().False(suite.app.EvmKeeper.IsContractFrozen(suite.ctx, suite.address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterPreinstalls() {
+	preinstall := types.Preinstall{
+		Name:    "test",
+		Address: utiltx.GenerateAddress().Hex(),
+		Code:    "0x600160005260206000f3",
+	}
+	testCases := []struct {
+		name      string
+		request   *types.MsgRegisterPreinstalls
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgRegisterPreinstalls{Authority: "foobar", Preinstalls: []types.Preinstall{preinstall}},
+			expectErr: true,
+		},
+		{
+			name: "fail - address with code",
+			request: &types.MsgRegisterPreinstalls{
+				Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Preinstalls: []types.Preinstall{types.DefaultPreinstalls[0]},
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - register preinstall",
+			request: &types.MsgRegisterPreinstalls{
+				Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Preinstalls: []types.Preinstall{preinstall},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.app.EvmKeeper.RegisterPreinstalls(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			for _, p := range tc.request.Preinstalls {
+				acct := suite.app.EvmKeeper.GetAccount(suite.ctx, common.HexToAddress(p.Address))
+				suite.Require().NotNil(acct)
+				suite.Require().Equal(p.GetCodeBytes(), suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acct.CodeHash)))
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// The contract permissions complement the EnableCreate and EnableCall parameters with two lists
+// managed by governance:
+//   - the deployer allowlist restricts the contract deployments to the listed addresses, which can
+//     be externally owned accounts sending contract creation txs or factory contracts running the
+//     CREATE and CREATE2 opcodes. An empty allowlist allows every address to deploy contracts.
+//   - the frozen contracts reject all the calls, including the DELEGATECALL and CALLCODE of their
+//     code by other contracts.
+//
+// The lists are enforced by the EVM at every call depth through the CanCreate and CanCall
+// functions of the block context, the topmost call is also checked on the AnteHandler.
+
+// GetDeployerAllowlist returns the addresses allowed to deploy contracts.
+func (k Keeper) GetDeployerAllowlist(ctx sdk.Context) []common.Address {
+	return k.getAddresses(ctx, types.KeyPrefixDeployerAllowlist)
+}
+
+// SetDeployerAllowlist replaces the addresses allowed to deploy contracts.
+func (k Keeper) SetDeployerAllowlist(ctx sdk.Context, deployers []common.Address) {
+	k.setAddresses(ctx, types.KeyPrefixDeployerAllowlist, deployers)
+}
+
+// IsDeployerAllowed returns true if the address is allowed to deploy contracts, that is if it's
+// in the deployer allowlist or if the allowlist is empty.
+func (k Keeper) IsDeployerAllowed(ctx sdk.Context, address common.Address) bool {
+	return !k.hasAddresses(ctx, types.KeyPrefixDeployerAllowlist) ||
+		ctx.KVStore(k.storeKey).Has(types.DeployerAllowlistKey(address))
+}
+
+// GetFrozenContracts returns the addresses of the frozen contracts.
+func (k Keeper) GetFrozenContracts(ctx sdk.Context) []common.Address {
+	return k.getAddresses(ctx, types.KeyPrefixFrozenContract)
+}
+
+// SetFrozenContracts replaces the addresses of the frozen contracts.
+func (k Keeper) SetFrozenContracts(ctx sdk.Context, contracts []common.Address) {
+	k.setAddresses(ctx, types.KeyPrefixFrozenContract, contracts)
+}
+
+// IsContractFrozen returns true if the contract at the address is frozen.
+func (k Keeper) IsContractFrozen(ctx sdk.Context, address common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FrozenContractKey(address))
+}
+
+// CanCreateFn implements vm.CanCreateFunc, it returns nil if the deployer allowlist is empty so
+// that the EVM doesn't check the deployers.
+func (k Keeper) CanCreateFn(ctx sdk.Context) vm.CanCreateFunc {
+	if !k.hasAddresses(ctx, types.KeyPrefixDeployerAllowlist) {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	return func(_ vm.StateDB, address common.Address) bool {
+		return store.Has(types.DeployerAllowlistKey(address))
+	}
+}
+
+// CanCallFn implements vm.CanCallFunc, it returns nil if there is no frozen contract so that the
+// EVM doesn't check the called contracts.
+func (k Keeper) CanCallFn(ctx sdk.Context) vm.CanCallFunc {
+	if !k.hasAddresses(ctx, types.KeyPrefixFrozenContract) {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	return func(_ vm.StateDB, address common.Address) bool {
+		return !store.Has(types.FrozenContractKey(address))
+	}
+}
+
+// getAddresses returns the addresses stored under the given prefix.
+func (k Keeper) getAddresses(ctx sdk.Context, keyPrefix []byte) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	addresses := []common.Address{}
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, common.BytesToAddress(iterator.Key()))
+	}
+	return addresses
+}
+
+// setAddresses replaces the addresses stored under the given prefix.
+func (k Keeper) setAddresses(ctx sdk.Context, keyPrefix []byte, addresses []common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	for _, address := range k.getAddresses(ctx, keyPrefix) {
+		store.Delete(address.Bytes())
+	}
+	for _, address := range addresses {
+		store.Set(address.Bytes(), []byte{1})
+	}
+}
+
+// hasAddresses returns true if there is at least one address stored under the given prefix.
+func (k Keeper) hasAddresses(ctx sdk.Context, keyPrefix []byte) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer iterator.Close()
+	return iterator.Valid()
+}
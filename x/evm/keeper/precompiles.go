@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// SetPrecompiles registers the stateful precompiled contracts available to the EVM. Only the
+// ones listed in the ActivePrecompiles parameter are enabled on the EVM instances.
+// It should be called only once during initialization, it panics if called more than once, if
+// an address is registered twice or if it's the one of a default precompiled contract.
+func (k *Keeper) SetPrecompiles(precompiles ...types.StatefulPrecompiledContract) *Keeper {
+	if k.precompiles != nil {
+		panic("cannot set evm precompiles twice")
+	}
+
+	registered := make(map[common.Address]vm.PrecompiledContract, len(precompiles))
+	for _, precompile := range precompiles {
+		addr := precompile.Address()
+		if _, found := vm.PrecompiledContractsBerlin[addr]; found {
+			panic(fmt.Errorf("precompile %s overrides a default precompiled contract", addr))
+		}
+		if _, found := registered[addr]; found {
+			panic(fmt.Errorf("precompile %s registered twice", addr))
+		}
+		registered[addr] = precompile
+	}
+
+	k.precompiles = registered
+	return k
+}
+
+// IsPrecompileRegistered returns true if a stateful precompiled contract is registered at the given address.
+func (k Keeper) IsPrecompileRegistered(addr common.Address) bool {
+	_, found := k.precompiles[addr]
+	return found
+}
+
+// GetActivePrecompiles returns the registered stateful precompiled contracts enabled by the
+// given parameters, keyed by address. Enabled addresses without a registered contract are skipped.
+func (k Keeper) GetActivePrecompiles(params types.Params) map[common.Address]vm.PrecompiledContract {
+	if len(k.precompiles) == 0 || len(params.ActivePrecompiles) == 0 {
+		return nil
+	}
+
+	active := make(map[common.Address]vm.PrecompiledContract, len(params.ActivePrecompiles))
+	for _, addr := range params.ActivePrecompileAddresses() {
+		if precompile, found := k.precompiles[addr]; found {
+			active[addr] = precompile
+		}
+	}
+	return active
+}
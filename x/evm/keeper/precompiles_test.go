@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	"github.com/servprotocolorg/serv/v12/x/evm/keeper"
+	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+var mintPrecompileAddress = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+// mintPrecompile is a stateful precompiled contract minting a fixed amount of the EVM
+// denomination to its caller through the bank keeper.
+type mintPrecompile struct {
+	bankKeeper types.BankKeeper
+	denom      string
+	amount     sdkmath.Int
+}
+
+func (p mintPrecompile) Address() common.Address {
+	return mintPrecompileAddress
+}
+
+func (p mintPrecompile) RequiredGas(_ []byte) uint64 {
+	return 3000
+}
+
+func (p mintPrecompile) Run(_ []byte) ([]byte, error) {
+	return nil, errors.New("stateful precompile")
+}
+
+func (p mintPrecompile) RunStateful(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if readOnly {
+		return nil, errors.New("write protection")
+	}
+	stateDB, ok := evm.StateDB.(statedb.ExtStateDB)
+	if !ok {
+		return nil, errors.New("not an extended state db")
+	}
+	return nil, stateDB.ExecuteNativeAction(func(ctx sdk.Context) error {
+		coins := sdk.NewCoins(sdk.NewCoin(p.denom, p.amount))
+		if err := p.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		return p.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contract.Caller().Bytes(), coins)
+	})
+}
+
+func (suite *KeeperTestSuite) TestStatefulPrecompile() {
+	var (
+		// calls the precompile with no input and reverts
+		revertingCaller = common.FromHex("0x600060006000600060006109005af150600080fd")
+		// calls the precompile with no input and stops
+		caller = common.FromHex("0x600060006000600060006109005af100")
+		minted = big.NewInt(100)
+	)
+
+	testCases := []struct {
+		name        string
+		active      bool
+		code        []byte
+		value       *big.Int
+		expSender   *big.Int
+		expContract *big.Int
+		expRevert   bool
+	}{
+		{
+			"inactive precompile is a regular account",
+			false,
+			nil,
+			big.NewInt(0),
+			big.NewInt(1000),
+			big.NewInt(0),
+			false,
+		},
+		{
+			"direct call mints to the sender",
+			true,
+			nil,
+			big.NewInt(0),
+			big.NewInt(1100),
+			big.NewInt(0),
+			false,
+		},
+		{
+			"value sent along is kept by the precompile",
+			true,
+			nil,
+			big.NewInt(10),
+			big.NewInt(1090),
+			big.NewInt(0),
+			false,
+		},
+		{
+			"call from a contract mints to the contract",
+			true,
+			caller,
+			big.NewInt(0),
+			big.NewInt(1000),
+			minted,
+			false,
+		},
+		{
+			"call from a reverting contract is reverted",
+			true,
+			revertingCaller,
+			big.NewInt(0),
+			big.NewInt(1000),
+			big.NewInt(0),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.FundDefaultAddress(1000)
+
+			denom := suite.EvmDenom()
+			// the app keeper already has the built-in precompiles registered
+			k := suite.newKeeper()
+			k.SetPrecompiles(mintPrecompile{
+				bankKeeper: suite.app.BankKeeper,
+				denom:      denom,
+				amount:     sdkmath.NewIntFromBigInt(minted),
+			})
+
+			params := k.GetParams(suite.ctx)
+			if tc.active {
+				params.ActivePrecompiles = []string{mintPrecompileAddress.Hex()}
+			}
+			suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+			to := mintPrecompileAddress
+			if tc.code != nil {
+				to = common.HexToAddress("0x0000000000000000000000000000000000001000")
+				vmdb := suite.StateDB()
+				vmdb.SetCode(to, tc.code)
+				suite.Require().NoError(vmdb.Commit())
+			}
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := k.EVMConfig(suite.ctx, proposerAddress, k.ChainID())
+			suite.Require().NoError(err)
+
+			msg := ethtypes.NewMessage(
+				suite.address, &to, 0, tc.value, 100_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+			)
+			evm := k.NewEVM(suite.ctx, msg, config, nil, suite.StateDB())
+			suite.Require().Equal(tc.active, containsAddress(evm.ActivePrecompiles(), mintPrecompileAddress))
+
+			txConfig := k.TxConfig(suite.ctx, common.Hash{})
+			res, err := k.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRevert, res.Failed())
+
+			balance := func(addr common.Address) *big.Int {
+				return suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), denom).Amount.BigInt()
+			}
+			suite.Require().Equal(tc.expSender, balance(suite.address))
+			suite.Require().Equal(tc.value, balance(mintPrecompileAddress))
+			if tc.code != nil {
+				suite.Require().Equal(tc.expContract, balance(to))
+			}
+		})
+	}
+}
+
+// newKeeper returns an EVM keeper sharing the stores of the app one, without precompiles registered.
+func (suite *KeeperTestSuite) newKeeper() *keeper.Keeper {
+	return keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetTKey(types.TransientKey),
+		authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper, suite.app.BankKeeper,
+		suite.app.StakingKeeper, suite.app.FeeMarketKeeper, "", suite.app.GetSubspace(types.ModuleName),
+	)
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
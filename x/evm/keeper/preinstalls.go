@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evertypes "github.com/servprotocolorg/serv/v12/types"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// AddPreinstalls places the code and the storage of the preinstalls at their addresses without a
+// deployment transaction. The address of a preinstall must not be a registered precompile or an
+// account with code or a nonce, the balance of an existing account is kept.
+func (k *Keeper) AddPreinstalls(ctx sdk.Context, preinstalls []types.Preinstall) error {
+	for _, preinstall := range preinstalls {
+		address := common.HexToAddress(preinstall.Address)
+		if k.IsPrecompileRegistered(address) {
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s is a precompile address", preinstall.Address)
+		}
+
+		acct := k.accountKeeper.GetAccount(ctx, sdk.AccAddress(address.Bytes()))
+		if _, ok := acct.(evertypes.EthAccountI); acct != nil && !ok {
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s is not an ethereum account", preinstall.Address)
+		}
+
+		account := k.GetAccountOrEmpty(ctx, address)
+		if account.IsContract() || account.Nonce != 0 {
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s is an account in use", preinstall.Address)
+		}
+
+		code := preinstall.GetCodeBytes()
+		codeHash := crypto.Keccak256Hash(code)
+		k.SetCode(ctx, codeHash.Bytes(), code)
+
+		account.CodeHash = codeHash.Bytes()
+		if err := k.SetAccount(ctx, address, account); err != nil {
+			return err
+		}
+
+		for _, state := range preinstall.Storage {
+			k.SetState(ctx, address, common.HexToHash(state.Key), common.HexToHash(state.Value).Bytes())
+		}
+	}
+
+	return nil
+}
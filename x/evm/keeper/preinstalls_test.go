@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestAddPreinstalls() {
+	// PUSH1 0x01 PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	code := "0x600160005260206000f3"
+	address := utiltx.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"new account", func() {}, true},
+		{
+			"account with balance",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, address, big.NewInt(100)))
+			},
+			true,
+		},
+		{
+			"account with nonce",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetNonce(address, 1)
+				suite.Require().NoError(vmdb.Commit())
+			},
+			false,
+		},
+		{
+			"account with code",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(address, []byte{0x00})
+				suite.Require().NoError(vmdb.Commit())
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+			balance := suite.app.EvmKeeper.GetBalance(suite.ctx, address)
+
+			err := suite.app.EvmKeeper.AddPreinstalls(suite.ctx, []types.Preinstall{
+				{
+					Name:    "test",
+					Address: address.Hex(),
+					Code:    code,
+					Storage: types.Storage{{Key: slot.Hex(), Value: value.Hex()}},
+				},
+			})
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrInvalidPreinstall)
+				return
+			}
+			suite.Require().NoError(err)
+
+			acct := suite.app.EvmKeeper.GetAccount(suite.ctx, address)
+			suite.Require().NotNil(acct)
+			suite.Require().Equal(crypto.Keccak256(common.FromHex(code)), acct.CodeHash)
+			suite.Require().Equal(balance, acct.Balance)
+			suite.Require().Equal(common.FromHex(code), suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acct.CodeHash)))
+			suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, address, slot))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDefaultPreinstalls() {
+	call := func(to common.Address, data []byte) []byte {
+		proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+		cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+		suite.Require().NoError(err)
+
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := ethtypes.NewMessage(
+			suite.address, &to, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+		)
+		txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+		res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, cfg, txConfig)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res.Ret
+	}
+
+	for _, preinstall := range types.DefaultPreinstalls {
+		acct := suite.app.EvmKeeper.GetAccount(suite.ctx, common.HexToAddress(preinstall.Address))
+		suite.Require().NotNil(acct, preinstall.Name)
+		suite.Require().Equal(crypto.Keccak256(preinstall.GetCodeBytes()), acct.CodeHash, preinstall.Name)
+	}
+
+	// Multicall3 getChainId()
+	ret := call(common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"), common.FromHex("0x3408e470"))
+	suite.Require().Equal(suite.app.EvmKeeper.ChainID(), new(big.Int).SetBytes(ret))
+
+	// Permit2 DOMAIN_SEPARATOR() is recomputed for the chain id
+	permit2 := common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3")
+	ret = call(permit2, common.FromHex("0x3644e515"))
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("Permit2")),
+		common.BigToHash(suite.app.EvmKeeper.ChainID()).Bytes(),
+		common.LeftPadBytes(permit2.Bytes(), 32),
+	)
+	suite.Require().Equal(domainSeparator, ret)
+
+	// Safe getThreshold() of the singletons
+	for _, safe := range []string{"0x69f4D1788e39c87893C980c06EdF4b7f686e2938", "0xfb1bffC9d739B8D520DaF37dF666da4C687191EA"} {
+		ret = call(common.HexToAddress(safe), common.FromHex("0xe75235b8"))
+		suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), ret)
+	}
+
+	// the CREATE2 deterministic deployment proxy deploys the init code with the salt
+	salt := common.BigToHash(big.NewInt(1))
+	// PUSH1 0x01 PUSH1 0x00 RETURN: deploys the runtime code 0x00
+	initCode := common.FromHex("0x60016000f3")
+	deployer := common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+	ret = call(deployer, append(salt.Bytes(), initCode...))
+	suite.Require().Equal(crypto.CreateAddress2(deployer, salt, crypto.Keccak256(initCode)).Bytes(), ret)
+}
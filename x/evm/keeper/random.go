@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+// The randomness of a block is the value of the PREVRANDAO opcode (the RANDOM opcode of the merge,
+// formerly DIFFICULTY) and the mixHash of the block header returned by the JSON-RPC API.
+//
+// The randomness of the block H+1 is computed at the end of the block H from the randomness of the
+// block H and the consensus data of the header of the block H:
+//
+//	random(H+1) = keccak256(random(H) || LastCommitHash(H) || AppHash(H))
+//
+// LastCommitHash(H) commits to the precommit signatures of the validators on the block H-1 and
+// AppHash(H) to the state after the block H-1. The randomness is stored in the evm store, so the
+// txs of the block H+1, the queries and the traces of the txs of the block H+1 on the state of the
+// block H use the same value. It's emitted in the end block events of the block H+1 for the
+// JSON-RPC API. The randomness is the zero hash until the end of the first block after the
+// genesis. The EVM only returns it from the MergeNetsplitBlock of the chain config, before it the
+// opcode is DIFFICULTY and returns 0.
+//
+// The randomness isn't safe against the validators, it must not be used to settle valuable
+// outcomes:
+//   - it's public one block in advance: the randomness of the block H+1 is known by everyone
+//     at the end of the block H, before the txs of the block H+1 are proposed.
+//   - the proposer of the block H chooses the precommits of the block H-1 included in the last
+//     commit, any subset of the signatures with more than 2/3 of the voting power is valid, so it
+//     can pick the randomness of the block H+1 between 2^n values, n being the number of the
+//     signatures above the 2/3 threshold.
+//   - the validators can withhold their precommits and the proposer can skip its turn to propose,
+//     each giving them one more candidate value.
+//   - the proposer of the block H-1 chooses the txs and thus AppHash(H), it can't predict the
+//     signatures of the other validators but it biases the value together with the proposer of
+//     the block H if they collude.
+
+// GetBlockRandom returns the randomness of the current block.
+func (k Keeper) GetBlockRandom(ctx sdk.Context) common.Hash {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBlockRandom)
+	if len(bz) == 0 {
+		return common.Hash{}
+	}
+	return common.BytesToHash(bz)
+}
+
+// SetBlockRandom sets the randomness of the next block.
+func (k Keeper) SetBlockRandom(ctx sdk.Context, random common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBlockRandom, random.Bytes())
+}
+
+// EmitBlockRandomEvent emits the randomness of the current block.
+func (k Keeper) EmitBlockRandomEvent(ctx sdk.Context, random common.Hash) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockRandom,
+			sdk.NewAttribute(types.AttributeKeyBlockRandom, random.Hex()),
+		),
+	)
+}
+
+// NextBlockRandom returns the randomness of the block following the block of the header.
+func NextBlockRandom(random common.Hash, header tmproto.Header) common.Hash {
+	return crypto.Keccak256Hash(random.Bytes(), header.LastCommitHash, header.AppHash)
+}
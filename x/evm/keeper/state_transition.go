@@ -15,19 +15,21 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 // NewEVM generates a go-ethereum VM from the provided Message fields and the chain parameters
 // (ChainConfig and module Params). It additionally sets the validator operator address as the
 // coinbase address to make it available for the COINBASE opcode, even though there is no
-// beneficiary of the coinbase transaction (since we're not mining).
-//
-// NOTE: the RANDOM opcode is currently not supported since it requires
-// RANDAO implementation. See https://github.com/evmos/ethermint/pull/1520#pullrequestreview-1200504697
-// for more information.
+// beneficiary of the coinbase transaction (since we're not mining). The stateful precompiled
+// contracts registered on the keeper and enabled through the ActivePrecompiles parameter are
+// made available to the EVM. From the MergeNetsplitBlock of the chain config, the RANDOM opcode
+// returns the block randomness, see GetBlockRandom. Before it, the block context has no randomness
+// so that the opcode 0x44 remains the DIFFICULTY opcode returning 0.
+// The deployer allowlist and the frozen contracts are enforced by the EVM at every call depth.
 
 func (k *Keeper) NewEVM(
 	ctx sdk.Context,
@@ -37,24 +39,55 @@ func (k *Keeper) NewEVM(
 	stateDB vm.StateDB,
 ) *vm.EVM {
 	blockCtx := vm.BlockContext{
-		CanTransfer: core.CanTransfer,
-		Transfer:    core.Transfer,
+		CanTransfer: canTransfer,
+		Transfer:    transfer,
 		GetHash:     k.GetHashFn(ctx),
+		CanCreate:   k.CanCreateFn(ctx),
+		CanCall:     k.CanCallFn(ctx),
 		Coinbase:    cfg.CoinBase,
 		GasLimit:    evertypes.BlockGasLimit(ctx),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
-		Random:      nil, // not supported
 	}
+	if isMerge(cfg.ChainConfig, blockCtx.BlockNumber) {
+		random := k.GetBlockRandom(ctx)
+		blockCtx.Random = &random
+	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
-	txCtx := core.NewEVMTxContext(msg)
+	txCtx := vm.TxContext{
+		Origin:   msg.From(),
+		GasPrice: new(big.Int).Set(msg.GasPrice()),
+	}
 	if tracer == nil {
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	evm := vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	if precompiles := k.GetActivePrecompiles(cfg.Params); len(precompiles) > 0 {
+		evm.WithPrecompiles(precompiles)
+	}
+	return evm
+}
+
+// isMerge returns true if the block number is at or after the MergeNetsplitBlock of the chain
+// config, the merge is never activated if the block isn't set.
+func isMerge(chainConfig *params.ChainConfig, number *big.Int) bool {
+	return chainConfig.MergeNetsplitBlock != nil && number.Cmp(chainConfig.MergeNetsplitBlock) >= 0
+}
+
+// canTransfer checks whether there are enough funds in the address' account to make a transfer.
+// This does not take the necessary gas in to account to make the transfer valid.
+func canTransfer(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+	return db.GetBalance(addr).Cmp(amount) >= 0
+}
+
+// transfer subtracts amount from sender and adds amount to recipient using the given Db
+func transfer(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+	db.SubBalance(sender, amount)
+	db.AddBalance(recipient, amount)
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -91,8 +124,13 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 			return common.BytesToHash(headerHash)
 
 		case ctx.BlockHeight() > h:
-			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
-			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// Case 2: if the chain is not the current height we need to retrieve the hash from the block hash
+			// ring buffer. This only applies if the current height is greater than the requested height.
+			if hash, found := k.GetBlockHash(ctx, height); found {
+				return hash
+			}
+
+			// the heights tracked before the ring buffer was introduced are read from the historical info
 			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if !found {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
@@ -320,6 +358,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.Overrides != nil {
+		if err := stateDB.ApplyOverrides(*cfg.Overrides); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -352,8 +395,12 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
+	if cfg.ChainConfig.IsBerlin(big.NewInt(ctx.BlockHeight())) {
+		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(), msg.AccessList())
+	}
+	// EIP-3651: the coinbase is warm from the start of the transaction
+	if cfg.ChainConfig.IsShanghai(evm.Context.BlockNumber) {
+		stateDB.AddAddressToAccessList(evm.Context.Coinbase)
 	}
 
 	if contractCreation {
@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/servprotocolorg/serv/v12/constants"
 	"github.com/servprotocolorg/serv/v12/testutil"
 	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
 	cointypes "github.com/servprotocolorg/serv/v12/types"
 	"github.com/servprotocolorg/serv/v12/x/evm/keeper"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 	"github.com/servprotocolorg/serv/v12/x/evm/statedb"
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -90,6 +92,25 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 			},
 			common.BytesToHash(hash),
 		},
+		{
+			"case 2.4: height lower than current one, read from the block hash ring buffer",
+			1,
+			func() {
+				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 1, &stakingtypes.HistoricalInfo{Header: header})
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.HexToHash("0x01"))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.HexToHash("0x01"),
+		},
+		{
+			"case 2.5: height lower than current one, stale slot of the block hash ring buffer",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1+types.BlockHashHistoryServeWindow, common.HexToHash("0x01"))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.Hash{},
+		},
 		{
 			"case 3: height greater than current one",
 			200,
@@ -251,6 +272,33 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			true,
 			params.TxGas + params.TxDataNonZeroGasEIP2028*1,
 		},
+		{
+			"with 33 non zero data, no accesslist, is contract creation, is shanghai",
+			bytes.Repeat([]byte{1}, 33),
+			nil,
+			4,
+			true,
+			true,
+			params.TxGasContractCreation + params.TxDataNonZeroGasEIP2028*33 + vm.InitCodeWordGas*2,
+		},
+		{
+			"initcode larger than the limit, is contract creation, is shanghai",
+			make([]byte, vm.MaxInitCodeSize+1),
+			nil,
+			4,
+			true,
+			false,
+			0,
+		},
+		{
+			"initcode larger than the limit, is contract creation, not shanghai",
+			make([]byte, vm.MaxInitCodeSize+1),
+			nil,
+			3,
+			true,
+			true,
+			params.TxGasContractCreation + params.TxDataZeroGas*uint64(vm.MaxInitCodeSize+1),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -261,6 +309,8 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			ethCfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
 			ethCfg.HomesteadBlock = big.NewInt(2)
 			ethCfg.IstanbulBlock = big.NewInt(3)
+			ethCfg.ShanghaiBlock = big.NewInt(4)
+			ethCfg.CancunBlock = big.NewInt(4)
 			signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
 
 			suite.ctx = suite.ctx.WithBlockHeight(tc.height)
@@ -530,6 +580,62 @@ func (suite *KeeperTestSuite) TestEVMConfig() {
 	suite.Require().Equal(types.DefaultParams().ChainConfig.EthereumConfig(big.NewInt(constants.TestnetEIP155ChainId)), cfg.ChainConfig)
 }
 
+func (suite *KeeperTestSuite) TestBlockRandom() {
+	// PREVRANDAO PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	code := common.FromHex("0x4460005260206000f3")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000001000")
+
+	testCases := []struct {
+		name      string
+		random    common.Hash
+		mergeNext bool
+		expRandom common.Hash
+	}{
+		{"no randomness", common.Hash{}, false, common.Hash{}},
+		{"randomness", common.HexToHash("0x0102"), false, common.HexToHash("0x0102")},
+		{"DIFFICULTY before the merge", common.HexToHash("0x0102"), true, common.Hash{}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contract, code)
+			suite.Require().NoError(vmdb.Commit())
+			suite.app.EvmKeeper.SetBlockRandom(suite.ctx, tc.random)
+
+			if tc.mergeNext {
+				evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+				mergeBlock := sdk.NewInt(suite.ctx.BlockHeight() + 1)
+				// the later forks can't be activated before the merge
+				evmParams.ChainConfig.MergeNetsplitBlock = &mergeBlock
+				evmParams.ChainConfig.ShanghaiBlock = &mergeBlock
+				evmParams.ChainConfig.CancunBlock = &mergeBlock
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+			}
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+
+			msg := ethtypes.NewMessage(
+				suite.address, &contract, 0, big.NewInt(0), 100_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+			)
+			evm := suite.app.EvmKeeper.NewEVM(suite.ctx, msg, cfg, nil, suite.StateDB())
+			if tc.mergeNext {
+				suite.Require().Nil(evm.Context.Random)
+			} else {
+				suite.Require().Equal(tc.random, *evm.Context.Random)
+			}
+
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, cfg, txConfig)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(tc.expRandom.Bytes(), res.Ret)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestContractDeployment() {
 	contractAddress := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(10000000000000))
 	db := suite.StateDB()
@@ -1280,3 +1386,76 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestContractPermissions() {
+	// STOP
+	target := common.HexToAddress("0x0000000000000000000000000000000000002000")
+	// PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 CREATE PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	factory := common.HexToAddress("0x0000000000000000000000000000000000002001")
+	// PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 PUSH20 target GAS CALL
+	// PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	caller := common.HexToAddress("0x0000000000000000000000000000000000002002")
+	vmdb := suite.StateDB()
+	vmdb.SetCode(target, []byte{byte(vm.STOP)})
+	vmdb.SetCode(factory, common.FromHex("0x600060006000f060005260206000f3"))
+	vmdb.SetCode(caller, append(append(common.FromHex("0x6000600060006000600073"), target.Bytes()...), common.FromHex("0x5af160005260206000f3")...))
+	suite.Require().NoError(vmdb.Commit())
+
+	apply := func(to *common.Address) *types.MsgEthereumTxResponse {
+		proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+		cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+		suite.Require().NoError(err)
+
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := ethtypes.NewMessage(
+			suite.address, to, nonce, big.NewInt(0), 200_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+		)
+		txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+		res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, cfg, txConfig)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	testCases := []struct {
+		name          string
+		deployers     []common.Address
+		frozen        []common.Address
+		expCreate     bool
+		expFactory    bool
+		expCallTarget bool
+	}{
+		{"no permissions", nil, nil, true, true, true},
+		{"sender allowed", []common.Address{suite.address}, nil, true, false, true},
+		{"factory allowed", []common.Address{factory}, nil, false, true, true},
+		{"target frozen", nil, []common.Address{target}, true, true, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.app.EvmKeeper.SetDeployerAllowlist(suite.ctx, tc.deployers)
+			suite.app.EvmKeeper.SetFrozenContracts(suite.ctx, tc.frozen)
+
+			res := apply(nil)
+			if tc.expCreate {
+				suite.Require().False(res.Failed(), res.VmError)
+			} else {
+				suite.Require().Equal(vm.ErrDeployerNotAllowed.Error(), res.VmError)
+			}
+
+			// the nested creation failure doesn't revert the call to the factory
+			res = apply(&factory)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(tc.expFactory, common.BytesToAddress(res.Ret) != common.Address{})
+
+			res = apply(&caller)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(tc.expCallTarget, new(big.Int).SetBytes(res.Ret).Sign() == 1)
+
+			res = apply(&target)
+			if tc.expCallTarget {
+				suite.Require().False(res.Failed(), res.VmError)
+			} else {
+				suite.Require().Equal(vm.ErrContractFrozen.Error(), res.VmError)
+			}
+		})
+	}
+}
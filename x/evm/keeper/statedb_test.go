@@ -5,6 +5,7 @@ import (
 	"github.com/servprotocolorg/serv/v12/constants"
 	"math/big"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -19,8 +20,9 @@ import (
 	"github.com/servprotocolorg/serv/v12/x/evm/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 func (suite *KeeperTestSuite) TestCreateAccount() {
@@ -443,6 +445,78 @@ func (suite *KeeperTestSuite) TestCommittedState() {
 	suite.Require().Equal(value2, tmp)
 }
 
+func (suite *KeeperTestSuite) TestCommittedStateNativeAction() {
+	key := common.BytesToHash([]byte("key"))
+	valueA := common.BytesToHash([]byte("valueA"))
+	valueB := common.BytesToHash([]byte("valueB"))
+	valueC := common.BytesToHash([]byte("valueC"))
+
+	testCases := []struct {
+		name   string
+		action func(ctx sdk.Context) error
+	}{
+		{
+			"native action copies the dirty value",
+			func(sdk.Context) error { return nil },
+		},
+		{
+			"native action changes the evm storage",
+			func(ctx sdk.Context) error {
+				suite.app.EvmKeeper.SetState(ctx, suite.address, key, valueC.Bytes())
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetState(suite.address, key, valueA)
+			suite.Require().NoError(vmdb.Commit())
+
+			// the slot is restored to its original value after the native action
+			vmdb = suite.StateDB()
+			vmdb.SetState(suite.address, key, valueB)
+			suite.Require().NoError(vmdb.ExecuteNativeAction(tc.action))
+			vmdb.SetState(suite.address, key, valueA)
+			suite.Require().NoError(vmdb.Commit())
+
+			suite.Require().Equal(valueA, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStorageProofClearedSlot() {
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+
+	vmdb := suite.StateDB()
+	vmdb.SetState(suite.address, key, value)
+	suite.Require().NoError(vmdb.Commit())
+	suite.Commit()
+
+	// the slot cleared by the EVM is kept in the store with a zero value
+	vmdb = suite.StateDB()
+	vmdb.SetState(suite.address, key, common.Hash{})
+	suite.Require().NoError(vmdb.Commit())
+	suite.Commit()
+
+	res := suite.app.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:   types.StateKey(suite.address, key.Bytes()),
+		Height: suite.app.LastBlockHeight(),
+		Prove:  true,
+	})
+	suite.Require().Zero(res.Code, res.Log)
+	suite.Require().Equal(common.Hash{}.Bytes(), res.Value)
+
+	proof := types.EncodeProof(res.ProofOps)
+	appHash := suite.app.LastCommitID().Hash
+	suite.Require().NoError(types.VerifyStorageProof(appHash, suite.address, key, common.Hash{}, proof))
+	suite.Require().ErrorIs(types.VerifyStorageProof(appHash, suite.address, key, value, proof), types.ErrInvalidProof)
+}
+
 func (suite *KeeperTestSuite) TestSuicide() {
 	code := []byte("code")
 	db := suite.StateDB()
@@ -44,4 +44,10 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are applied to the StateDB before executing the message,
+	// only used by queries such as `eth_call` and `eth_estimateGas`.
+	Overrides *types.StateOverride
+	// BlockOverrides are applied to the block context of the EVM, only used by
+	// queries such as `debug_traceCall`.
+	BlockOverrides *types.BlockOverrides
 }
@@ -3,17 +3,20 @@ package statedb
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 // ExtStateDB defines an extension to the interface provided by the go-ethereum
 // codebase to support additional state transition functionalities. In particular
 // it supports appending a new entry to the state journal through
 // AppendJournalEntry so that the state can be reverted after running
-// stateful precompiled contracts.
+// stateful precompiled contracts, and running actions on the native (Cosmos SDK)
+// state through ExecuteNativeAction.
 type ExtStateDB interface {
 	vm.StateDB
 	AppendJournalEntry(JournalEntry)
+	ExecuteNativeAction(action func(ctx sdk.Context) error) error
 }
 
 // Keeper provide underlying storage of StateDB
@@ -118,6 +118,10 @@ type (
 		account       *common.Address
 		key, prevalue common.Hash
 	}
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
 	codeChange struct {
 		account            *common.Address
 		prevcode, prevhash []byte
@@ -137,6 +141,11 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the native state
+	nativeChange struct {
+		index int
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -199,6 +208,14 @@ func (ch storageChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.transientStorage.Set(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch refundChange) Revert(s *StateDB) {
 	s.refund = ch.prev
 }
@@ -239,3 +256,11 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch nativeChange) Revert(s *StateDB) {
+	s.nativeStates = s.nativeStates[:ch.index]
+}
+
+func (ch nativeChange) Dirtied() *common.Address {
+	return nil
+}
@@ -63,6 +63,9 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// fakeStorage is set when the whole storage is overridden, the committed
+	// state is then never loaded from the keeper.
+	fakeStorage bool
 }
 
 // newObject creates a state object.
@@ -139,7 +142,7 @@ func (s *stateObject) Code() []byte {
 	if bytes.Equal(s.CodeHash(), emptyCodeHash) {
 		return nil
 	}
-	code := s.db.keeper.GetCode(s.db.ctx, common.BytesToHash(s.CodeHash()))
+	code := s.db.keeper.GetCode(s.db.currentCtx(), common.BytesToHash(s.CodeHash()))
 	s.code = code
 	return code
 }
@@ -200,8 +203,11 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.fakeStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
-	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
+	value := s.db.keeper.GetState(s.db.currentCtx(), s.Address(), key)
 	s.originStorage[key] = value
 	return value
 }
@@ -233,3 +239,15 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire storage of the account with the given one,
+// slots not present in it are considered empty. It's only meant to be used
+// for call simulations with state overrides, so the change is not journaled.
+func (s *stateObject) SetStorage(storage Storage) {
+	s.originStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.originStorage[key] = value
+	}
+	s.dirtyStorage = make(Storage)
+	s.fakeStorage = true
+}
@@ -1,6 +1,7 @@
 package statedb
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/servprotocolorg/serv/v12/utils"
 	"math/big"
@@ -10,8 +11,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
+	"github.com/servprotocolorg/serv/v12/x/evm/types"
 )
 
 // revision is the identifier of a version of state.
@@ -22,7 +25,10 @@ type revision struct {
 	journalIndex int
 }
 
-var _ vm.StateDB = &StateDB{}
+var (
+	_ vm.StateDB = &StateDB{}
+	_ ExtStateDB = &StateDB{}
+)
 
 // preventCommit is a flag to prevent committing state changes to the underlying storage.
 // This is used for testing purposes to simulate cases where Commit() is failed.
@@ -55,6 +61,21 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Per-transaction EIP-1153 transient storage
+	transientStorage transientStorage
+
+	// Branches of the native (Cosmos SDK) state created by the native actions of the
+	// stateful precompiled contracts, each one branches off the previous one, the first
+	// one branches off ctx.
+	nativeStates []nativeState
+}
+
+// nativeState is a branch of the native state together with the function writing it
+// to its parent.
+type nativeState struct {
+	ctx   sdk.Context
+	write func()
 }
 
 // New creates a new state from a given trie.
@@ -66,6 +87,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -80,6 +103,16 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// currentCtx returns the context of the latest native state branch, or the transaction
+// context when no native action was executed. The keeper is always read through it so
+// that the changes of the native actions are visible.
+func (s *StateDB) currentCtx() sdk.Context {
+	if len(s.nativeStates) == 0 {
+		return s.ctx
+	}
+	return s.nativeStates[len(s.nativeStates)-1].ctx
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -217,7 +250,7 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 		return obj
 	}
 	// If no live objects are available, load it from keeper
-	account := s.keeper.GetAccount(s.ctx, addr)
+	account := s.keeper.GetAccount(s.currentCtx(), addr)
 	if account == nil {
 		return nil
 	}
@@ -277,7 +310,7 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
-	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
+	s.keeper.ForEachStorage(s.currentCtx(), addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
 		}
@@ -313,6 +346,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(new(big.Int).Set(amount))
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -337,6 +378,73 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// GetTransientState returns the value of the key of the transient storage of the account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
+// SetTransientState sets the value of the key of the transient storage of the account, the change
+// is journaled to be reverted with the snapshots.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	s.transientStorage.Set(addr, key, value)
+}
+
+// SetStorage replaces the entire storage of the account, see stateObject.SetStorage.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
+// ApplyOverrides overrides the fields of the given accounts, it's used by the
+// `eth_call` and `eth_estimateGas` queries to simulate a call on a modified state.
+func (s *StateDB) ApplyOverrides(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	// sort the addresses for deterministic iteration
+	addrs := make([]common.Address, 0, len(overrides))
+	for addr := range overrides {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	for _, addr := range addrs {
+		account := overrides[addr]
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			s.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			s.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -419,6 +527,77 @@ func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addre
 	return s.accessList.Contains(addr, slot)
 }
 
+// AppendJournalEntry appends a custom entry to the journal, so that it's reverted
+// together with the other state changes.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
+// ExecuteNativeAction executes an action modifying the native (Cosmos SDK) state, e.g. on
+// behalf of a stateful precompiled contract. The action runs on a new branch of the native
+// state, which includes the dirty EVM state, so that it's reverted when the action fails or
+// when the journal is reverted past this call. The cached accounts are synced with the
+// changes of the action afterwards.
+func (s *StateDB) ExecuteNativeAction(action func(ctx sdk.Context) error) error {
+	ctx, write := s.currentCtx().CacheContext()
+	if err := s.commitToContext(ctx); err != nil {
+		return err
+	}
+	if err := action(ctx); err != nil {
+		return err
+	}
+
+	s.nativeStates = append(s.nativeStates, nativeState{ctx: ctx, write: write})
+	s.journal.append(nativeChange{index: len(s.nativeStates) - 1})
+	s.syncStateObjects(ctx)
+	return nil
+}
+
+// syncStateObjects updates the cached accounts whose balance, nonce, code or cached storage
+// differ from the ones in the given context, the changes are journaled.
+func (s *StateDB) syncStateObjects(ctx sdk.Context) {
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	for _, addr := range addrs {
+		obj := s.stateObjects[addr]
+		if obj.suicided {
+			continue
+		}
+		account := s.keeper.GetAccount(ctx, addr)
+		if account == nil {
+			continue
+		}
+		if obj.Balance().Cmp(account.Balance) != 0 {
+			obj.SetBalance(account.Balance)
+		}
+		if obj.Nonce() != account.Nonce {
+			obj.SetNonce(account.Nonce)
+		}
+		if !bytes.Equal(obj.CodeHash(), account.CodeHash) {
+			codeHash := common.BytesToHash(account.CodeHash)
+			obj.SetCode(codeHash, s.keeper.GetCode(ctx, codeHash))
+		}
+		keys := make(Storage, len(obj.originStorage)+len(obj.dirtyStorage))
+		for key := range obj.originStorage {
+			keys[key] = common.Hash{}
+		}
+		for key := range obj.dirtyStorage {
+			keys[key] = common.Hash{}
+		}
+		for _, key := range keys.SortedKeys() {
+			if value := s.keeper.GetState(ctx, addr, key); value != obj.GetState(key) {
+				obj.SetState(key, value)
+			}
+		}
+	}
+}
+
 // Snapshot returns an identifier for the current revision of the state.
 func (s *StateDB) Snapshot() int {
 	id := s.nextRevisionID
@@ -449,26 +628,43 @@ func (s *StateDB) Commit() error {
 	if preventCommit {
 		return fmt.Errorf("failed to commit state changes")
 	}
+	if err := s.commitToContext(s.currentCtx()); err != nil {
+		return err
+	}
+	// write the native state branches back to the transaction context
+	for i := len(s.nativeStates) - 1; i >= 0; i-- {
+		s.nativeStates[i].write()
+	}
+	return nil
+}
+
+// commitToContext writes the dirty states to keeper using the given context.
+func (s *StateDB) commitToContext(ctx sdk.Context) error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.suicided {
-			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
+			if err := s.keeper.DeleteAccount(ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
 		} else {
 			if obj.code != nil && obj.dirtyCode {
-				s.keeper.SetCode(s.ctx, obj.CodeHash(), obj.code)
+				s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
 			}
-			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
+			if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				value := obj.dirtyStorage[key]
-				// Skip noop changes, persist actual changes
-				if value == obj.originStorage[key] {
+				// Skip noop changes, persist actual changes. The native state branches may
+				// hold a value different from the origin one, so they are compared with
+				// the value of the context.
+				if len(s.nativeStates) == 0 && value == obj.originStorage[key] {
+					continue
+				}
+				if len(s.nativeStates) > 0 && value == s.keeper.GetState(ctx, obj.Address(), key) {
 					continue
 				}
-				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+				s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
 			}
 		}
 	}
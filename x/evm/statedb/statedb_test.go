@@ -8,9 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 var (
@@ -244,6 +245,29 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetStorage(address, statedb.Storage{key2: value2})
+	// slots missing from the overridden storage are empty
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+
+	// the storage can still be modified on top of the override
+	db.SetState(address, key1, value2)
+	suite.Require().Equal(value2, db.GetState(address, key1))
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -385,6 +409,37 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// the transient storage is discarded at the end of the tx
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
@@ -0,0 +1,31 @@
+package statedb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// transientStorage is the EIP-1153 transient storage of the accounts, it's discarded at the end of
+// the transaction.
+type transientStorage map[common.Address]Storage
+
+// newTransientStorage creates a new empty transient storage.
+func newTransientStorage() transientStorage {
+	return make(transientStorage)
+}
+
+// Set sets the value of the key of the transient storage of the account.
+func (t transientStorage) Set(addr common.Address, key, value common.Hash) {
+	if _, ok := t[addr]; !ok {
+		t[addr] = make(Storage)
+	}
+	t[addr][key] = value
+}
+
+// Get returns the value of the key of the transient storage of the account.
+func (t transientStorage) Get(addr common.Address, key common.Hash) common.Hash {
+	storage, ok := t[addr]
+	if !ok {
+		return common.Hash{}
+	}
+	return storage[key]
+}
@@ -23,7 +23,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName            = "ethermint/MsgUpdateParams"
+	updateDeployerAllowlistName = "ethermint/MsgUpdateDeployerAllowlist"
+	updateFrozenContractsName   = "ethermint/MsgUpdateFrozenContracts"
+	registerPreinstallsName     = "ethermint/MsgRegisterPreinstalls"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -42,6 +45,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgUpdateDeployerAllowlist{},
+		&MsgUpdateFrozenContracts{},
+		&MsgRegisterPreinstalls{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -89,4 +95,7 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateDeployerAllowlist{}, updateDeployerAllowlistName, nil)
+	cdc.RegisterConcrete(&MsgUpdateFrozenContracts{}, updateFrozenContractsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterPreinstalls{}, registerPreinstallsName, nil)
 }
@@ -27,6 +27,11 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrInvalidPrecompile
+	codeErrInvalidProof
+	codeErrDeployerNotAllowed
+	codeErrContractFrozen
+	codeErrInvalidPreinstall
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -76,6 +81,21 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrInvalidPrecompile returns an error if an active precompile is not registered.
+	ErrInvalidPrecompile = errorsmod.Register(ModuleName, codeErrInvalidPrecompile, "invalid precompile")
+
+	// ErrInvalidProof returns an error if a state proof is malformed or doesn't match the app hash.
+	ErrInvalidProof = errorsmod.Register(ModuleName, codeErrInvalidProof, "invalid state proof")
+
+	// ErrDeployerNotAllowed returns an error if the sender isn't in the deployer allowlist.
+	ErrDeployerNotAllowed = errorsmod.Register(ModuleName, codeErrDeployerNotAllowed, "deployer not allowed")
+
+	// ErrContractFrozen returns an error if the called contract is frozen.
+	ErrContractFrozen = errorsmod.Register(ModuleName, codeErrContractFrozen, "contract frozen")
+
+	// ErrInvalidPreinstall returns an error if a preinstall can't be placed at its address.
+	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -40,6 +40,9 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// active_precompiles defines the hex addresses of the stateful precompiled
+	// contracts registered on the EVM keeper which are enabled.
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +120,13 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -263,6 +273,79 @@ func (m *State) GetValue() string {
 	return ""
 }
 
+// Preinstall defines a contract placed at a fixed address without a deployment transaction.
+type Preinstall struct {
+	// name of the preinstalled contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address defines the hex formatted address of the contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code defines the hex bytes of the contract runtime code
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// storage defines the initial storage of the contract
+	Storage Storage `protobuf:"bytes,4,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+}
+
+func (m *Preinstall) Reset()         { *m = Preinstall{} }
+func (m *Preinstall) String() string { return proto.CompactTextString(m) }
+func (*Preinstall) ProtoMessage()    {}
+func (*Preinstall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *Preinstall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Preinstall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Preinstall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Preinstall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preinstall.Merge(m, src)
+}
+func (m *Preinstall) XXX_Size() int {
+	return m.Size()
+}
+func (m *Preinstall) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preinstall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preinstall proto.InternalMessageInfo
+
+func (m *Preinstall) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Preinstall) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Preinstall) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Preinstall) GetStorage() Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 // Log represents an protobuf compatible Ethereum Log that defines a contract
 // log event. These events are generated by the LOG opcode and stored/indexed by
 // the node.
@@ -296,7 +379,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +483,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +543,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,6 +653,7 @@ func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
+	proto.RegisterType((*Preinstall)(nil), "ethermint.evm.v1.Preinstall")
 	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
@@ -578,99 +662,105 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5d, 0x6f, 0xdb, 0xb6,
-	0x1a, 0xce, 0x87, 0x92, 0xc8, 0xb4, 0xe3, 0x28, 0x8c, 0x9b, 0xfa, 0xb4, 0x38, 0x51, 0xa0, 0x8b,
-	0x83, 0x00, 0xa7, 0x8d, 0x9b, 0x14, 0xc1, 0x29, 0x5a, 0x9c, 0x01, 0x71, 0xe2, 0xb5, 0xc9, 0xb6,
-	0x2e, 0x60, 0x3a, 0x0c, 0x18, 0x30, 0x08, 0xb4, 0xc4, 0xda, 0x6a, 0x24, 0xd1, 0x20, 0x29, 0xd7,
-	0xde, 0xf6, 0x03, 0xb6, 0xbb, 0xfd, 0x82, 0xa1, 0x3f, 0xa7, 0xd8, 0x55, 0x2f, 0x87, 0x5d, 0x08,
-	0x43, 0x7a, 0x97, 0x4b, 0xff, 0x82, 0x41, 0x24, 0xad, 0xd8, 0x4e, 0xb0, 0xcd, 0xbe, 0xb2, 0x9e,
-	0xe7, 0x7d, 0xf9, 0x3c, 0x2f, 0x5f, 0x52, 0x26, 0x05, 0xee, 0x11, 0xd1, 0x26, 0x2c, 0x0a, 0x62,
-	0x51, 0x23, 0xdd, 0xa8, 0xd6, 0xdd, 0xcb, 0x7e, 0x76, 0x3b, 0x8c, 0x0a, 0x0a, 0xad, 0x3c, 0xb6,
-	0x9b, 0x91, 0xdd, 0xbd, 0x7b, 0x95, 0x16, 0x6d, 0x51, 0x19, 0xac, 0x65, 0x4f, 0x2a, 0xcf, 0xf9,
-	0x69, 0x11, 0x2c, 0x9f, 0x61, 0x86, 0x23, 0x0e, 0xf7, 0x40, 0x81, 0x74, 0x23, 0xd7, 0x27, 0x31,
-	0x8d, 0xaa, 0xf3, 0xdb, 0xf3, 0x3b, 0x85, 0x7a, 0x65, 0x90, 0xda, 0x56, 0x1f, 0x47, 0xe1, 0x53,
-	0x27, 0x0f, 0x39, 0xc8, 0x24, 0xdd, 0xe8, 0x38, 0x7b, 0x84, 0xff, 0x07, 0xab, 0x24, 0xc6, 0xcd,
-	0x90, 0xb8, 0x1e, 0x23, 0x58, 0x90, 0xea, 0xc2, 0xf6, 0xfc, 0x8e, 0x59, 0xaf, 0x0e, 0x52, 0xbb,
-	0xa2, 0x87, 0x8d, 0x86, 0x1d, 0x54, 0x52, 0xf8, 0x48, 0x42, 0xf8, 0x3f, 0x50, 0x1c, 0xc6, 0x71,
-	0x18, 0x56, 0x17, 0xe5, 0xe0, 0xcd, 0x41, 0x6a, 0xc3, 0xf1, 0xc1, 0x38, 0x0c, 0x1d, 0x04, 0xf4,
-	0x50, 0x1c, 0x86, 0xf0, 0x10, 0x00, 0xd2, 0x13, 0x0c, 0xbb, 0x24, 0xe8, 0xf0, 0xaa, 0xb1, 0xbd,
-	0xb8, 0xb3, 0x58, 0x77, 0x2e, 0x53, 0xbb, 0xd0, 0xc8, 0xd8, 0xc6, 0xc9, 0x19, 0x1f, 0xa4, 0xf6,
-	0xba, 0x16, 0xc9, 0x13, 0x1d, 0x54, 0x90, 0xa0, 0x11, 0x74, 0x38, 0xfc, 0x16, 0x94, 0xbc, 0x36,
-	0x0e, 0x62, 0xd7, 0xa3, 0xf1, 0xeb, 0xa0, 0x55, 0x5d, 0xda, 0x9e, 0xdf, 0x29, 0xee, 0xff, 0x7b,
-	0x77, 0xb2, 0x6f, 0xbb, 0x47, 0x59, 0xd6, 0x91, 0x4c, 0xaa, 0xdf, 0x7f, 0x9f, 0xda, 0x73, 0x83,
-	0xd4, 0xde, 0x50, 0xd2, 0xa3, 0x02, 0x0e, 0x2a, 0x7a, 0xd7, 0x99, 0x70, 0x1f, 0xdc, 0xc1, 0x61,
-	0x48, 0xdf, 0xba, 0x49, 0x9c, 0x35, 0x9a, 0x78, 0x82, 0xf8, 0xae, 0xe8, 0xf1, 0xea, 0x72, 0x36,
-	0x49, 0xb4, 0x21, 0x83, 0x5f, 0x5d, 0xc7, 0x5e, 0xf5, 0xb8, 0xf3, 0xcb, 0x3a, 0x28, 0x8e, 0xb8,
-	0xc1, 0x08, 0xac, 0xb5, 0x69, 0x44, 0xb8, 0x20, 0xd8, 0x77, 0x9b, 0x21, 0xf5, 0x2e, 0xf4, 0xb2,
-	0x1c, 0xff, 0x9e, 0xda, 0xff, 0x69, 0x05, 0xa2, 0x9d, 0x34, 0x77, 0x3d, 0x1a, 0xd5, 0x3c, 0xca,
-	0x23, 0xca, 0xf5, 0xcf, 0x43, 0xee, 0x5f, 0xd4, 0x44, 0xbf, 0x43, 0xf8, 0xee, 0x49, 0x2c, 0x06,
-	0xa9, 0xbd, 0xa9, 0x8a, 0x9d, 0x90, 0x72, 0x50, 0x39, 0x67, 0xea, 0x19, 0x01, 0xfb, 0xa0, 0xec,
-	0x63, 0xea, 0xbe, 0xa6, 0xec, 0x42, 0xbb, 0x2d, 0x48, 0xb7, 0xf3, 0x7f, 0xee, 0x76, 0x99, 0xda,
-	0xa5, 0xe3, 0xc3, 0x2f, 0x3f, 0xa5, 0xec, 0x42, 0x6a, 0x0e, 0x52, 0xfb, 0x8e, 0x72, 0x1f, 0x57,
-	0x76, 0x50, 0xc9, 0xc7, 0x34, 0x4f, 0x83, 0x5f, 0x03, 0x2b, 0x4f, 0xe0, 0x49, 0xa7, 0x43, 0x99,
-	0xd0, 0xbb, 0xe1, 0xe1, 0x65, 0x6a, 0x97, 0xb5, 0xe4, 0xb9, 0x8a, 0x0c, 0x52, 0xfb, 0xee, 0x84,
-	0xa8, 0x1e, 0xe3, 0xa0, 0xb2, 0x96, 0xd5, 0xa9, 0x90, 0x83, 0x12, 0x09, 0x3a, 0x7b, 0x07, 0x8f,
-	0xf4, 0x8c, 0x0c, 0x39, 0xa3, 0xb3, 0xa9, 0x66, 0x54, 0x6c, 0x9c, 0x9c, 0xed, 0x1d, 0x3c, 0x1a,
-	0x4e, 0x48, 0xaf, 0xfd, 0xa8, 0xac, 0x83, 0x8a, 0x0a, 0xaa, 0xd9, 0x9c, 0x00, 0x0d, 0xdd, 0x36,
-	0xe6, 0x6d, 0xb9, 0xb3, 0x0a, 0xf5, 0x9d, 0xcb, 0xd4, 0x06, 0x4a, 0xe9, 0x05, 0xe6, 0xed, 0xeb,
-	0x75, 0x69, 0xf6, 0xbf, 0xc3, 0xb1, 0x08, 0x92, 0x68, 0xa8, 0x05, 0xd4, 0xe0, 0x2c, 0x2b, 0xaf,
-	0xff, 0x40, 0xd7, 0xbf, 0x3c, 0x73, 0xfd, 0x07, 0xb7, 0xd5, 0x7f, 0x30, 0x5e, 0xbf, 0xca, 0xc9,
-	0x4d, 0x9f, 0x68, 0xd3, 0x95, 0x99, 0x4d, 0x9f, 0xdc, 0x66, 0xfa, 0x64, 0xdc, 0x54, 0xe5, 0x64,
-	0x9b, 0x7d, 0xa2, 0x13, 0x55, 0x73, 0xf6, 0xcd, 0x7e, 0xa3, 0xa9, 0xe5, 0x9c, 0x51, 0x76, 0x3f,
-	0x80, 0x8a, 0x47, 0x63, 0x2e, 0x32, 0x2e, 0xa6, 0x9d, 0x90, 0x68, 0xcf, 0x82, 0xf4, 0x3c, 0x99,
-	0xca, 0xf3, 0xbe, 0xfe, 0x37, 0xb8, 0x45, 0xcf, 0x41, 0x1b, 0xe3, 0xb4, 0x72, 0xef, 0x00, 0xab,
-	0x43, 0x04, 0x61, 0xbc, 0x99, 0xb0, 0x96, 0x76, 0x06, 0xd2, 0xb9, 0x31, 0x95, 0xb3, 0x7e, 0x0f,
-	0x26, 0xb5, 0x1c, 0xb4, 0x76, 0x4d, 0x29, 0xc7, 0x37, 0xa0, 0x1c, 0x64, 0x65, 0x34, 0x93, 0x50,
-	0xfb, 0x15, 0xa5, 0xdf, 0xd1, 0x54, 0x7e, 0xfa, 0x65, 0x1e, 0x57, 0x72, 0xd0, 0xea, 0x90, 0x50,
-	0x5e, 0x09, 0x80, 0x51, 0x12, 0x30, 0xb7, 0x15, 0x62, 0x2f, 0x20, 0x4c, 0xfb, 0x95, 0xa4, 0xdf,
-	0xf3, 0xa9, 0xfc, 0xfe, 0xa5, 0xfc, 0x6e, 0xaa, 0x39, 0xc8, 0xca, 0xc8, 0xe7, 0x8a, 0x53, 0xb6,
-	0x3e, 0x28, 0x35, 0x09, 0x0b, 0x83, 0x58, 0x1b, 0xae, 0x4a, 0xc3, 0xc3, 0xa9, 0x0c, 0xf5, 0x3e,
-	0x1d, 0xd5, 0x71, 0x50, 0x51, 0xc1, 0xdc, 0x25, 0xa4, 0xb1, 0x4f, 0x87, 0x2e, 0xeb, 0xb3, 0xbb,
-	0x8c, 0xea, 0x38, 0xa8, 0xa8, 0xa0, 0x72, 0xe9, 0x81, 0x0d, 0xcc, 0x18, 0x7d, 0x3b, 0xd1, 0x43,
-	0x28, 0xcd, 0x5e, 0x4c, 0x65, 0x76, 0x4f, 0x99, 0xdd, 0x22, 0xe7, 0xa0, 0x75, 0xc9, 0x8e, 0x75,
-	0x31, 0x01, 0xb0, 0xc5, 0x70, 0x7f, 0xc2, 0xb8, 0x32, 0xfb, 0xe2, 0xdd, 0x54, 0x73, 0x90, 0x95,
-	0x91, 0x63, 0xb6, 0xdf, 0x83, 0x4a, 0x44, 0x58, 0x8b, 0xb8, 0x31, 0x11, 0xbc, 0x13, 0x06, 0x42,
-	0x1b, 0xdf, 0x99, 0xfd, 0x7d, 0xbc, 0x4d, 0xcf, 0x41, 0x50, 0xd2, 0x2f, 0x35, 0x9b, 0xbf, 0x1c,
-	0xbc, 0x8d, 0xe3, 0x56, 0x1b, 0x07, 0xda, 0x76, 0x73, 0xf6, 0x97, 0x63, 0x5c, 0xc9, 0x41, 0xab,
-	0x43, 0x22, 0xdf, 0x3f, 0x1e, 0x8e, 0xbd, 0x64, 0xb8, 0x7f, 0xee, 0xce, 0xbe, 0x7f, 0x46, 0x75,
-	0xb2, 0xeb, 0x87, 0x84, 0xd2, 0xe5, 0xd4, 0x30, 0xcb, 0xd6, 0xda, 0xa9, 0x61, 0xae, 0x59, 0xd6,
-	0xa9, 0x61, 0x5a, 0xd6, 0xfa, 0xa9, 0x61, 0x6e, 0x58, 0x15, 0xb4, 0xda, 0xa7, 0x21, 0x75, 0xbb,
-	0x8f, 0xd5, 0x20, 0x54, 0x24, 0x6f, 0x31, 0xd7, 0xff, 0x91, 0xa8, 0xec, 0x61, 0x81, 0xc3, 0x3e,
-	0xd7, 0xad, 0x42, 0x96, 0x6a, 0xe0, 0xc8, 0xa9, 0x5d, 0x03, 0x4b, 0xe7, 0x22, 0xbb, 0xb8, 0x59,
-	0x60, 0xf1, 0x82, 0xf4, 0xd5, 0x6d, 0x04, 0x65, 0x8f, 0xb0, 0x02, 0x96, 0xba, 0x38, 0x4c, 0xd4,
-	0x0d, 0xb0, 0x80, 0x14, 0x70, 0x7e, 0x5d, 0x00, 0x8b, 0x9f, 0xd3, 0x16, 0xac, 0x82, 0x15, 0xec,
-	0xfb, 0x8c, 0x70, 0xae, 0xc7, 0x0c, 0x21, 0xdc, 0x04, 0xcb, 0x82, 0x76, 0x02, 0x8f, 0x57, 0x17,
-	0xb6, 0x17, 0x77, 0x0a, 0x48, 0x23, 0x08, 0x81, 0xe1, 0x63, 0x81, 0xe5, 0x2d, 0xa0, 0x84, 0xe4,
-	0x33, 0xdc, 0x07, 0x25, 0x59, 0x87, 0x1b, 0x27, 0x51, 0x93, 0x30, 0x79, 0x98, 0x1b, 0xf5, 0xb5,
-	0xab, 0xd4, 0x2e, 0x4a, 0xfe, 0xa5, 0xa4, 0xd1, 0x28, 0x80, 0x0f, 0xc0, 0x8a, 0xe8, 0x8d, 0x9e,
-	0xc3, 0x1b, 0x57, 0xa9, 0xbd, 0x26, 0x18, 0x8e, 0x39, 0xf6, 0x44, 0x40, 0xe3, 0xec, 0x98, 0x45,
-	0xcb, 0xa2, 0x27, 0x8f, 0xdb, 0x1a, 0x30, 0x45, 0xcf, 0x0d, 0x62, 0x9f, 0xf4, 0xe4, 0x51, 0x6b,
-	0xd4, 0x2b, 0x57, 0xa9, 0x6d, 0x8d, 0xa4, 0x9f, 0x64, 0x31, 0xb4, 0x22, 0x7a, 0xf2, 0x01, 0x3e,
-	0x00, 0x40, 0x95, 0x24, 0x1d, 0xd4, 0x41, 0xb9, 0x7a, 0x95, 0xda, 0x05, 0xc9, 0x4a, 0xed, 0xeb,
-	0x47, 0xe8, 0x80, 0x25, 0xa5, 0x6d, 0x4a, 0xed, 0xd2, 0x55, 0x6a, 0x9b, 0x21, 0x6d, 0x29, 0x4d,
-	0x15, 0xca, 0x5a, 0xc5, 0x48, 0x44, 0xbb, 0xc4, 0x97, 0x67, 0x91, 0x89, 0x86, 0xd0, 0xc1, 0xa0,
-	0x78, 0xe8, 0x79, 0x84, 0xf3, 0x57, 0x49, 0x27, 0x24, 0x7f, 0xd1, 0xd3, 0x7d, 0x50, 0xe2, 0x82,
-	0x32, 0xdc, 0x22, 0xee, 0x05, 0xe9, 0xeb, 0xce, 0xaa, 0x3e, 0x69, 0xfe, 0x33, 0xd2, 0xe7, 0x68,
-	0x14, 0x3c, 0x35, 0x7e, 0x7c, 0x67, 0xcf, 0x39, 0xef, 0x0c, 0x50, 0x7c, 0xc5, 0xb0, 0x47, 0xf4,
-	0x0d, 0x34, 0x5b, 0x9d, 0x0c, 0x32, 0x6d, 0xa1, 0x51, 0xe6, 0x2d, 0x82, 0x88, 0xd0, 0x44, 0xe8,
-	0xf5, 0x1e, 0xc2, 0x6c, 0x04, 0x23, 0xa4, 0x47, 0x3c, 0xb9, 0x72, 0x06, 0xd2, 0x08, 0x1e, 0x80,
-	0x55, 0x3f, 0xe0, 0xf2, 0x3a, 0xcf, 0x05, 0xf6, 0x2e, 0xe4, 0x6a, 0x98, 0x75, 0xeb, 0x2a, 0xb5,
-	0x4b, 0x3a, 0x70, 0x9e, 0xf1, 0x68, 0x0c, 0xc1, 0x67, 0x60, 0xed, 0x7a, 0x98, 0xac, 0x56, 0x5d,
-	0xa0, 0xeb, 0xf0, 0x2a, 0xb5, 0xcb, 0x79, 0xaa, 0x8c, 0xa0, 0x09, 0x9c, 0xed, 0x49, 0x9f, 0x34,
-	0x93, 0x96, 0x6c, 0xb7, 0x89, 0x14, 0xc8, 0xd8, 0x30, 0x88, 0x02, 0x21, 0xdb, 0xbb, 0x84, 0x14,
-	0x80, 0xcf, 0x40, 0x81, 0x76, 0x09, 0x63, 0x81, 0x4f, 0xb8, 0x3c, 0x8a, 0xff, 0xee, 0x5b, 0x00,
-	0x5d, 0xe7, 0x67, 0x93, 0xd3, 0x9f, 0x2a, 0x11, 0x89, 0x28, 0xeb, 0xcb, 0xb3, 0x55, 0x4f, 0x4e,
-	0x05, 0xbe, 0x90, 0x3c, 0x1a, 0x43, 0xb0, 0x0e, 0xa0, 0x1e, 0xc6, 0x88, 0x48, 0x58, 0xec, 0xca,
-	0x1d, 0x5f, 0x92, 0x63, 0xe5, 0xbe, 0x53, 0x51, 0x24, 0x83, 0xc7, 0x58, 0x60, 0x74, 0x83, 0x81,
-	0x9f, 0x00, 0xa8, 0xd6, 0xc4, 0x7d, 0xc3, 0x69, 0xfe, 0x31, 0xa3, 0x8e, 0x3e, 0xe9, 0xaf, 0xa2,
-	0xba, 0x66, 0x4b, 0xa1, 0x53, 0x4e, 0xf5, 0x2c, 0x4e, 0x0d, 0xd3, 0xb0, 0x96, 0x4e, 0x0d, 0x73,
-	0xc5, 0x32, 0xf3, 0xfe, 0xe9, 0x59, 0xa0, 0x8d, 0x21, 0x1e, 0x29, 0xaf, 0xde, 0x78, 0x7f, 0xb9,
-	0x35, 0xff, 0xe1, 0x72, 0x6b, 0xfe, 0x8f, 0xcb, 0xad, 0xf9, 0x9f, 0x3f, 0x6e, 0xcd, 0x7d, 0xf8,
-	0xb8, 0x35, 0xf7, 0xdb, 0xc7, 0xad, 0xb9, 0x6f, 0xfe, 0x3b, 0xf2, 0xff, 0xd5, 0xe0, 0x1e, 0x8e,
-	0xeb, 0x8d, 0x1a, 0xe9, 0xea, 0x0f, 0xd4, 0xee, 0xde, 0x7e, 0xad, 0x27, 0x3f, 0x53, 0xe5, 0x1f,
-	0x59, 0x73, 0x59, 0x7e, 0x7e, 0x3e, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x73, 0x9e, 0x51, 0x24,
-	0xc4, 0x0e, 0x00, 0x00,
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0xb6, 0x22, 0xd9, 0xa2, 0x46, 0xb2, 0x44, 0x8f, 0x15, 0x47, 0xcd, 0x62, 0x4d, 0x83, 0x87,
+	0xc2, 0x87, 0x5d, 0x6b, 0xed, 0x85, 0xd1, 0x60, 0x17, 0x2d, 0x60, 0xd9, 0xee, 0xae, 0xdd, 0x6d,
+	0x6a, 0x8c, 0x53, 0x14, 0x28, 0x50, 0x10, 0x23, 0x72, 0x22, 0x31, 0x26, 0x39, 0xc2, 0xcc, 0x50,
+	0x91, 0xda, 0xfe, 0x80, 0x1e, 0x8b, 0xfe, 0x80, 0x22, 0xe7, 0xfe, 0x92, 0xa0, 0xa7, 0xdc, 0x5a,
+	0xf4, 0xc0, 0x16, 0xce, 0xcd, 0x47, 0xfd, 0x82, 0x62, 0x3e, 0x24, 0x51, 0xb2, 0xb1, 0x88, 0x75,
+	0xe2, 0xbc, 0x5f, 0xcf, 0xf3, 0xce, 0x3b, 0x2f, 0xe7, 0x03, 0x3c, 0x27, 0xa2, 0x4f, 0x58, 0x1c,
+	0x26, 0xa2, 0x4d, 0x86, 0x71, 0x7b, 0x78, 0x28, 0x3f, 0x07, 0x03, 0x46, 0x05, 0x85, 0xf6, 0xcc,
+	0x76, 0x20, 0x95, 0xc3, 0xc3, 0xe7, 0xcd, 0x1e, 0xed, 0x51, 0x65, 0x6c, 0xcb, 0x91, 0xf6, 0x73,
+	0xff, 0x55, 0x04, 0x1b, 0x57, 0x98, 0xe1, 0x98, 0xc3, 0x43, 0x50, 0x21, 0xc3, 0xd8, 0x0b, 0x48,
+	0x42, 0xe3, 0x56, 0x61, 0xaf, 0xb0, 0x5f, 0xe9, 0x34, 0x27, 0x99, 0x63, 0x8f, 0x71, 0x1c, 0x7d,
+	0xe3, 0xce, 0x4c, 0x2e, 0xb2, 0xc8, 0x30, 0x3e, 0x93, 0x43, 0xf8, 0x73, 0xb0, 0x49, 0x12, 0xdc,
+	0x8d, 0x88, 0xe7, 0x33, 0x82, 0x05, 0x69, 0x3d, 0xd9, 0x2b, 0xec, 0x5b, 0x9d, 0xd6, 0x24, 0x73,
+	0x9a, 0x26, 0x2c, 0x6f, 0x76, 0x51, 0x4d, 0xcb, 0xa7, 0x4a, 0x84, 0x3f, 0x03, 0xd5, 0xa9, 0x1d,
+	0x47, 0x51, 0xab, 0xa8, 0x82, 0x77, 0x26, 0x99, 0x03, 0x17, 0x83, 0x71, 0x14, 0xb9, 0x08, 0x98,
+	0x50, 0x1c, 0x45, 0xf0, 0x04, 0x00, 0x32, 0x12, 0x0c, 0x7b, 0x24, 0x1c, 0xf0, 0x56, 0x69, 0xaf,
+	0xb8, 0x5f, 0xec, 0xb8, 0xb7, 0x99, 0x53, 0x39, 0x97, 0xda, 0xf3, 0x8b, 0x2b, 0x3e, 0xc9, 0x9c,
+	0x2d, 0x03, 0x32, 0x73, 0x74, 0x51, 0x45, 0x09, 0xe7, 0xe1, 0x80, 0xc3, 0x3f, 0x80, 0x9a, 0xdf,
+	0xc7, 0x61, 0xe2, 0xf9, 0x34, 0x79, 0x1d, 0xf6, 0x5a, 0xeb, 0x7b, 0x85, 0xfd, 0xea, 0xd1, 0xe7,
+	0x07, 0xcb, 0x75, 0x3b, 0x38, 0x95, 0x5e, 0xa7, 0xca, 0xa9, 0xf3, 0xd9, 0xfb, 0xcc, 0x59, 0x9b,
+	0x64, 0xce, 0xb6, 0x86, 0xce, 0x03, 0xb8, 0xa8, 0xea, 0xcf, 0x3d, 0xe1, 0x11, 0x78, 0x8a, 0xa3,
+	0x88, 0xbe, 0xf5, 0xd2, 0x44, 0x16, 0x9a, 0xf8, 0x82, 0x04, 0x9e, 0x18, 0xf1, 0xd6, 0x86, 0x9c,
+	0x24, 0xda, 0x56, 0xc6, 0xdf, 0xce, 0x6d, 0xaf, 0x46, 0x1c, 0xfe, 0x00, 0x20, 0xf6, 0x45, 0x38,
+	0x24, 0xde, 0x80, 0x11, 0x9f, 0xc6, 0x83, 0x30, 0x22, 0xbc, 0x55, 0xde, 0x2b, 0xee, 0x57, 0x3a,
+	0x9f, 0x4f, 0x32, 0xe7, 0x27, 0x9a, 0xf5, 0xbe, 0x8f, 0x8b, 0xb6, 0xb4, 0xf2, 0x2a, 0xa7, 0xfb,
+	0xfb, 0x16, 0xa8, 0xe6, 0x72, 0x87, 0x31, 0x68, 0xf4, 0x69, 0x4c, 0xb8, 0x20, 0x38, 0xf0, 0xba,
+	0x11, 0xf5, 0x6f, 0xcc, 0x22, 0x9f, 0xfd, 0x27, 0x73, 0x7e, 0xda, 0x0b, 0x45, 0x3f, 0xed, 0x1e,
+	0xf8, 0x34, 0x6e, 0xfb, 0x94, 0xc7, 0x94, 0x9b, 0xcf, 0x97, 0x3c, 0xb8, 0x69, 0x8b, 0xf1, 0x80,
+	0xf0, 0x83, 0x8b, 0x44, 0x4c, 0x32, 0x67, 0x47, 0x27, 0xb1, 0x04, 0xe5, 0xa2, 0xfa, 0x4c, 0xd3,
+	0x91, 0x0a, 0x38, 0x06, 0xf5, 0x00, 0x53, 0xef, 0x35, 0x65, 0x37, 0x86, 0xed, 0x89, 0x62, 0xbb,
+	0xfe, 0x74, 0xb6, 0xdb, 0xcc, 0xa9, 0x9d, 0x9d, 0xfc, 0xe6, 0x97, 0x94, 0xdd, 0x28, 0xcc, 0x49,
+	0xe6, 0x3c, 0xd5, 0xec, 0x8b, 0xc8, 0x2e, 0xaa, 0x05, 0x98, 0xce, 0xdc, 0xe0, 0xef, 0x80, 0x3d,
+	0x73, 0xe0, 0xe9, 0x60, 0x40, 0x99, 0x30, 0xbd, 0xf5, 0xe5, 0x6d, 0xe6, 0xd4, 0x0d, 0xe4, 0xb5,
+	0xb6, 0x4c, 0x32, 0xe7, 0xd9, 0x12, 0xa8, 0x89, 0x71, 0x51, 0xdd, 0xc0, 0x1a, 0x57, 0xc8, 0x41,
+	0x8d, 0x84, 0x83, 0xc3, 0xe3, 0xaf, 0xcc, 0x8c, 0x4a, 0x6a, 0x46, 0x57, 0x8f, 0x9a, 0x51, 0xf5,
+	0xfc, 0xe2, 0xea, 0xf0, 0xf8, 0xab, 0xe9, 0x84, 0x4c, 0x27, 0xe5, 0x61, 0x5d, 0x54, 0xd5, 0xa2,
+	0x9e, 0xcd, 0x05, 0x30, 0xa2, 0xd7, 0xc7, 0xbc, 0xaf, 0xfa, 0xb4, 0xd2, 0xd9, 0xbf, 0xcd, 0x1c,
+	0xa0, 0x91, 0xbe, 0xc7, 0xbc, 0x3f, 0x5f, 0x97, 0xee, 0xf8, 0x8f, 0x38, 0x11, 0x61, 0x1a, 0x4f,
+	0xb1, 0x80, 0x0e, 0x96, 0x5e, 0xb3, 0xfc, 0x8f, 0x4d, 0xfe, 0x1b, 0x2b, 0xe7, 0x7f, 0xfc, 0x50,
+	0xfe, 0xc7, 0x8b, 0xf9, 0x6b, 0x9f, 0x19, 0xe9, 0x0b, 0x43, 0x5a, 0x5e, 0x99, 0xf4, 0xc5, 0x43,
+	0xa4, 0x2f, 0x16, 0x49, 0xb5, 0x8f, 0x6c, 0xf6, 0xa5, 0x4a, 0xb4, 0xac, 0xd5, 0x9b, 0xfd, 0x5e,
+	0x51, 0xeb, 0x33, 0x8d, 0xa6, 0xfb, 0x33, 0x68, 0xfa, 0x34, 0xe1, 0x42, 0xea, 0x12, 0x3a, 0x88,
+	0x88, 0xe1, 0xac, 0x28, 0xce, 0x8b, 0x47, 0x71, 0x7e, 0x66, 0xf6, 0x96, 0x07, 0xf0, 0x5c, 0xb4,
+	0xbd, 0xa8, 0xd6, 0xec, 0x03, 0x60, 0x0f, 0x88, 0x20, 0x8c, 0x77, 0x53, 0xd6, 0x33, 0xcc, 0x40,
+	0x31, 0x9f, 0x3f, 0x8a, 0xd9, 0xfc, 0x07, 0xcb, 0x58, 0x2e, 0x6a, 0xcc, 0x55, 0x9a, 0xf1, 0x0d,
+	0xa8, 0x87, 0x32, 0x8d, 0x6e, 0x1a, 0x19, 0xbe, 0xaa, 0xe2, 0x3b, 0x7d, 0x14, 0x9f, 0xf9, 0x99,
+	0x17, 0x91, 0x5c, 0xb4, 0x39, 0x55, 0x68, 0xae, 0x14, 0xc0, 0x38, 0x0d, 0x99, 0xd7, 0x8b, 0xb0,
+	0x1f, 0x12, 0x66, 0xf8, 0x6a, 0x8a, 0xef, 0xbb, 0x47, 0xf1, 0x99, 0xfd, 0xf3, 0x3e, 0x9a, 0x8b,
+	0x6c, 0xa9, 0xfc, 0x4e, 0xeb, 0x34, 0x6d, 0x00, 0x6a, 0x5d, 0xc2, 0xa2, 0x30, 0x31, 0x84, 0x9b,
+	0x8a, 0xf0, 0xe4, 0x51, 0x84, 0xa6, 0x4f, 0xf3, 0x38, 0x2e, 0xaa, 0x6a, 0x71, 0xc6, 0x12, 0xd1,
+	0x24, 0xa0, 0x53, 0x96, 0xad, 0xd5, 0x59, 0xf2, 0x38, 0x2e, 0xaa, 0x6a, 0x51, 0xb3, 0x8c, 0xc0,
+	0x36, 0x66, 0x8c, 0xbe, 0x5d, 0xaa, 0x21, 0x54, 0x64, 0xdf, 0x3f, 0x8a, 0xec, 0xb9, 0x39, 0x83,
+	0xee, 0xc3, 0xc9, 0x43, 0x48, 0x6a, 0x17, 0xaa, 0x98, 0x02, 0xd8, 0x63, 0x78, 0xbc, 0x44, 0xdc,
+	0x5c, 0x7d, 0xf1, 0xee, 0xa3, 0xb9, 0xc8, 0x96, 0xca, 0x05, 0xda, 0x3f, 0x81, 0x66, 0x4c, 0x58,
+	0x8f, 0x78, 0x09, 0x11, 0x7c, 0x10, 0x85, 0xc2, 0x10, 0x3f, 0x5d, 0xfd, 0x7f, 0x7c, 0x08, 0xcf,
+	0x45, 0x50, 0xa9, 0x5f, 0x1a, 0xed, 0xec, 0xe7, 0xe0, 0x7d, 0x9c, 0xf4, 0xfa, 0x38, 0x34, 0xb4,
+	0x3b, 0xab, 0xff, 0x1c, 0x8b, 0x48, 0x2e, 0xda, 0x9c, 0x2a, 0x66, 0xfd, 0xe3, 0xe3, 0xc4, 0x4f,
+	0xa7, 0xfd, 0xf3, 0x6c, 0xf5, 0xfe, 0xc9, 0xe3, 0xc8, 0xcb, 0x8c, 0x12, 0x15, 0xcb, 0x65, 0xc9,
+	0xaa, 0xdb, 0x8d, 0xcb, 0x92, 0xd5, 0xb0, 0xed, 0xcb, 0x92, 0x65, 0xdb, 0x5b, 0x97, 0x25, 0x6b,
+	0xdb, 0x6e, 0xa2, 0xcd, 0x31, 0x8d, 0xa8, 0x37, 0xfc, 0x5a, 0x07, 0xa1, 0x2a, 0x79, 0x8b, 0xb9,
+	0xd9, 0x23, 0x51, 0xdd, 0xc7, 0x02, 0x47, 0x63, 0x6e, 0x4a, 0x85, 0x6c, 0x5d, 0xc0, 0xdc, 0xa9,
+	0xdd, 0x06, 0xeb, 0xd7, 0x42, 0x5e, 0x03, 0x6d, 0x50, 0xbc, 0x21, 0x63, 0x7d, 0x1b, 0x41, 0x72,
+	0x08, 0x9b, 0x60, 0x7d, 0x88, 0xa3, 0x54, 0xdf, 0x27, 0x2b, 0x48, 0x0b, 0xee, 0xdf, 0x0a, 0x00,
+	0x5c, 0x31, 0x12, 0xca, 0x1d, 0x30, 0x8a, 0x20, 0x04, 0xa5, 0x04, 0xc7, 0xc4, 0xc4, 0xa9, 0x31,
+	0x6c, 0x81, 0x32, 0x0e, 0x02, 0x46, 0x38, 0x37, 0xa1, 0x53, 0x51, 0x7a, 0xfb, 0x34, 0x20, 0xea,
+	0x22, 0x50, 0x41, 0x6a, 0x0c, 0x3b, 0xa0, 0xcc, 0x05, 0x65, 0xb8, 0x47, 0xd4, 0x1d, 0xb2, 0x7a,
+	0xf4, 0xec, 0xfe, 0xf5, 0x4f, 0xa5, 0xd8, 0x69, 0xc8, 0x8b, 0xdf, 0x3f, 0xfe, 0xeb, 0x94, 0xaf,
+	0xb5, 0x3f, 0x9a, 0x06, 0xba, 0xff, 0x7c, 0x02, 0x8a, 0x3f, 0xd0, 0x5e, 0x9e, 0xb9, 0xb0, 0xc8,
+	0xbc, 0x03, 0x36, 0x04, 0x1d, 0x84, 0xbe, 0x4c, 0xa9, 0xb8, 0x5f, 0x41, 0x46, 0x92, 0x19, 0x05,
+	0x58, 0x60, 0x95, 0x51, 0x0d, 0xa9, 0x31, 0x3c, 0x02, 0x35, 0x55, 0x1c, 0x2f, 0x49, 0xe3, 0x2e,
+	0x61, 0xea, 0x86, 0x51, 0xea, 0x34, 0xee, 0x32, 0xa7, 0xaa, 0xf4, 0x2f, 0x95, 0x1a, 0xe5, 0x05,
+	0xf8, 0x05, 0x28, 0x8b, 0x51, 0xfe, 0x72, 0xb0, 0x7d, 0x97, 0x39, 0x0d, 0xc1, 0x70, 0xc2, 0xe5,
+	0xad, 0x90, 0x26, 0xf2, 0xec, 0x47, 0x1b, 0x62, 0x24, 0xbf, 0xb0, 0x0d, 0x2c, 0x31, 0xf2, 0xc2,
+	0x24, 0x20, 0x23, 0x75, 0xfe, 0x97, 0x3a, 0xcd, 0xbb, 0xcc, 0xb1, 0x73, 0xee, 0x17, 0xd2, 0x86,
+	0xca, 0x62, 0xa4, 0x06, 0xf0, 0x0b, 0x00, 0x74, 0x4a, 0x8a, 0x41, 0x9f, 0xde, 0x9b, 0x77, 0x99,
+	0x53, 0x51, 0x5a, 0x85, 0x3d, 0x1f, 0x42, 0x17, 0xac, 0x6b, 0x6c, 0x4b, 0x61, 0xd7, 0xee, 0x32,
+	0xc7, 0x8a, 0x68, 0x4f, 0x63, 0x6a, 0x93, 0x2c, 0x15, 0x23, 0x31, 0x1d, 0x92, 0x40, 0x1d, 0x90,
+	0x16, 0x9a, 0x8a, 0x2e, 0x06, 0xd5, 0x13, 0xdf, 0x27, 0x9c, 0xbf, 0x4a, 0x07, 0x11, 0xf9, 0x91,
+	0x9a, 0x1e, 0x81, 0x9a, 0x59, 0x00, 0xef, 0x86, 0x8c, 0x4d, 0x65, 0x75, 0x9d, 0x8c, 0xfe, 0x57,
+	0x64, 0xcc, 0x51, 0x5e, 0xf8, 0xa6, 0xf4, 0x97, 0x77, 0xce, 0x9a, 0xfb, 0xae, 0x04, 0xaa, 0xaf,
+	0x18, 0xf6, 0x89, 0xb9, 0x16, 0xcb, 0xd5, 0x91, 0x22, 0x33, 0x14, 0x46, 0x92, 0xdc, 0x22, 0x8c,
+	0x09, 0x4d, 0xc5, 0xb4, 0x93, 0x8c, 0x28, 0x23, 0x18, 0x21, 0x23, 0xe2, 0xab, 0x95, 0x2b, 0x21,
+	0x23, 0xc1, 0x63, 0xb0, 0x19, 0x84, 0x5c, 0xbd, 0x58, 0xb8, 0xc0, 0xfe, 0x8d, 0x5a, 0x0d, 0xab,
+	0x63, 0xdf, 0x65, 0x4e, 0xcd, 0x18, 0xae, 0xa5, 0x1e, 0x2d, 0x48, 0xf0, 0x5b, 0xd0, 0x98, 0x87,
+	0xe9, 0x66, 0x54, 0x6f, 0x84, 0x0e, 0xbc, 0xcb, 0x9c, 0xfa, 0xcc, 0x55, 0xb7, 0xdd, 0x92, 0x2c,
+	0x7f, 0x94, 0x80, 0x74, 0xd3, 0x9e, 0x2a, 0xb7, 0x85, 0xb4, 0x20, 0xb5, 0x51, 0x18, 0x87, 0x42,
+	0x95, 0x77, 0x1d, 0x69, 0x01, 0x7e, 0x0b, 0x2a, 0x74, 0x48, 0x18, 0x0b, 0x03, 0xc2, 0x5b, 0xe0,
+	0x13, 0x9e, 0x3b, 0x68, 0xee, 0x2f, 0x27, 0x67, 0x5e, 0x63, 0x31, 0x89, 0x29, 0x1b, 0xb7, 0xaa,
+	0xf3, 0xc9, 0x69, 0xc3, 0xaf, 0x95, 0x1e, 0x2d, 0x48, 0xb0, 0x03, 0xa0, 0x09, 0x63, 0x44, 0xa4,
+	0x2c, 0xf1, 0x54, 0xc7, 0xd7, 0x54, 0xac, 0xea, 0x3b, 0x6d, 0x45, 0xca, 0x78, 0x86, 0x05, 0x46,
+	0xf7, 0x34, 0xf0, 0x17, 0x00, 0xea, 0x35, 0xf1, 0xde, 0x70, 0x3a, 0x7b, 0xaf, 0xe9, 0xf3, 0x58,
+	0xf1, 0x6b, 0xab, 0xc9, 0xd9, 0xd6, 0xd2, 0x25, 0xa7, 0x66, 0x16, 0x97, 0x25, 0xab, 0x64, 0xaf,
+	0x5f, 0x96, 0xac, 0xb2, 0x6d, 0xcd, 0xea, 0x67, 0x66, 0x81, 0xb6, 0xa7, 0x72, 0x2e, 0xbd, 0xce,
+	0xc5, 0xfb, 0xdb, 0xdd, 0xc2, 0x87, 0xdb, 0xdd, 0xc2, 0xff, 0x6e, 0x77, 0x0b, 0x7f, 0xfd, 0xb8,
+	0xbb, 0xf6, 0xe1, 0xe3, 0xee, 0xda, 0xbf, 0x3f, 0xee, 0xae, 0xfd, 0xbe, 0x9d, 0xdb, 0x54, 0x39,
+	0x61, 0x43, 0xf5, 0x86, 0xf6, 0x69, 0x44, 0x59, 0x4f, 0xc9, 0xed, 0xe1, 0xe1, 0x51, 0x7b, 0xa4,
+	0x5e, 0xe3, 0x6a, 0x87, 0xed, 0x6e, 0x28, 0x8f, 0xaf, 0xff, 0x3f, 0x00, 0xa6, 0xcd, 0x54, 0xd2,
+	0xab, 0x0f, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -693,6 +783,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1055,6 +1154,64 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Preinstall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Preinstall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Log) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1334,6 +1491,12 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1438,6 +1601,33 @@ func (m *State) Size() (n int) {
 	return n
 }
 
+func (m *Preinstall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Log) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1782,6 +1972,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2631,6 +2853,186 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Preinstall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Log) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/servprotocolorg/serv/v12/types"
 )
 
@@ -14,12 +16,13 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
-// DefaultGenesisState sets default evm genesis state with empty accounts and default params and
-// chain config values.
+// DefaultGenesisState sets default evm genesis state with empty accounts, the default preinstalls
+// and default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:    []GenesisAccount{},
+		Params:      DefaultParams(),
+		Preinstalls: append([]Preinstall{}, DefaultPreinstalls...),
 	}
 }
 
@@ -35,6 +38,7 @@ func NewGenesisState(params Params, accounts []GenesisAccount) *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	seenAccounts := make(map[string]bool)
+	accountAddrs := make(map[common.Address]bool)
 	for _, acc := range gs.Accounts {
 		if seenAccounts[acc.Address] {
 			return fmt.Errorf("duplicated genesis account %s", acc.Address)
@@ -43,6 +47,22 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("invalid genesis account %s: %w", acc.Address, err)
 		}
 		seenAccounts[acc.Address] = true
+		accountAddrs[common.HexToAddress(acc.Address)] = true
+	}
+
+	if err := validatePermissionAddresses(gs.DeployerAllowlist); err != nil {
+		return fmt.Errorf("invalid deployer allowlist: %w", err)
+	}
+	if err := validatePermissionAddresses(gs.FrozenContracts); err != nil {
+		return fmt.Errorf("invalid frozen contracts: %w", err)
+	}
+	if err := validatePreinstalls(gs.Preinstalls); err != nil {
+		return err
+	}
+	for _, preinstall := range gs.Preinstalls {
+		if accountAddrs[common.HexToAddress(preinstall.Address)] {
+			return fmt.Errorf("preinstall %s conflicts with genesis account %s", preinstall.Name, preinstall.Address)
+		}
 	}
 
 	return gs.Params.Validate()
@@ -29,6 +29,13 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// deployer_allowlist defines the hex addresses allowed to deploy contracts, an empty list allows
+	// every address to deploy contracts.
+	DeployerAllowlist []string `protobuf:"bytes,3,rep,name=deployer_allowlist,json=deployerAllowlist,proto3" json:"deployer_allowlist,omitempty"`
+	// frozen_contracts defines the hex addresses of the contracts rejecting all calls.
+	FrozenContracts []string `protobuf:"bytes,4,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts,omitempty"`
+	// preinstalls defines the contracts placed at fixed addresses at genesis.
+	Preinstalls []Preinstall `protobuf:"bytes,5,rep,name=preinstalls,proto3" json:"preinstalls"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +85,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDeployerAllowlist() []string {
+	if m != nil {
+		return m.DeployerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenContracts() []string {
+	if m != nil {
+		return m.FrozenContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreinstalls() []Preinstall {
+	if m != nil {
+		return m.Preinstalls
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,27 +180,32 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4a, 0xc3, 0x40,
-	0x1c, 0xce, 0xd9, 0xd2, 0xda, 0xab, 0xa8, 0x1c, 0x82, 0xa1, 0xc3, 0xb5, 0x74, 0x2a, 0x08, 0x77,
-	0xb4, 0x82, 0xbb, 0x07, 0xc5, 0x55, 0xd2, 0xcd, 0xed, 0x9a, 0xfe, 0x48, 0x3b, 0x24, 0x17, 0x72,
-	0xd7, 0x43, 0x57, 0x47, 0x27, 0x9f, 0xc3, 0x27, 0xe9, 0xd8, 0xd1, 0x49, 0x25, 0x79, 0x11, 0xc9,
-	0x25, 0x2d, 0x68, 0xb6, 0x2f, 0xf9, 0xfe, 0xfd, 0xee, 0xc3, 0x14, 0xcc, 0x1a, 0xb2, 0x78, 0x93,
-	0x18, 0x0e, 0x36, 0xe6, 0x76, 0xca, 0x23, 0x48, 0x40, 0x6f, 0x34, 0x4b, 0x33, 0x65, 0x14, 0xb9,
-	0x3c, 0xf2, 0x0c, 0x6c, 0xcc, 0xec, 0x74, 0x30, 0x68, 0x38, 0x4a, 0xc2, 0xa9, 0x07, 0x57, 0x91,
-	0x8a, 0x94, 0x83, 0xbc, 0x44, 0xd5, 0xdf, 0xf1, 0x1b, 0xc2, 0x67, 0x0f, 0x55, 0xea, 0xc2, 0x48,
-	0x03, 0x44, 0xe0, 0x53, 0x19, 0x86, 0x6a, 0x9b, 0x18, 0xed, 0xa3, 0x51, 0x6b, 0xd2, 0x9f, 0x8d,
-	0xd8, 0xff, 0x1e, 0x56, 0x3b, 0xee, 0x2b, 0xa1, 0x68, 0xef, 0xbe, 0x86, 0x5e, 0x70, 0xf4, 0x91,
-	0x3b, 0xdc, 0x49, 0x65, 0x26, 0x63, 0xed, 0x9f, 0x8c, 0xd0, 0xa4, 0x3f, 0xf3, 0x9b, 0x09, 0x8f,
-	0x8e, 0xaf, 0x9d, 0xb5, 0x7a, 0xfc, 0x8a, 0xf0, 0xf9, 0xdf, 0x68, 0xe2, 0xe3, 0xae, 0x5c, 0xad,
-	0x32, 0xd0, 0xe5, 0x35, 0x68, 0xd2, 0x0b, 0x0e, 0x9f, 0x84, 0xe0, 0x76, 0xa8, 0x56, 0xe0, 0x2a,
-	0x7a, 0x81, 0xc3, 0x44, 0xe0, 0xae, 0x36, 0x2a, 0x93, 0x11, 0xf8, 0x2d, 0x77, 0xfb, 0x75, 0xb3,
-	0xd9, 0x3d, 0x53, 0x5c, 0x94, 0xc5, 0x1f, 0xdf, 0xc3, 0xee, 0xa2, 0xd2, 0x07, 0x07, 0xa3, 0x98,
-	0xef, 0x72, 0x8a, 0xf6, 0x39, 0x45, 0x3f, 0x39, 0x45, 0xef, 0x05, 0xf5, 0xf6, 0x05, 0xf5, 0x3e,
-	0x0b, 0xea, 0x3d, 0xdd, 0x44, 0x1b, 0xb3, 0xde, 0x2e, 0x59, 0xa8, 0x62, 0x3e, 0xd7, 0xa1, 0x4c,
-	0xc4, 0x9c, 0x83, 0xad, 0xf7, 0xb6, 0xd3, 0x19, 0x7f, 0x76, 0xab, 0x9b, 0x97, 0x14, 0xf4, 0xb2,
-	0xe3, 0xf6, 0xbd, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x81, 0xc8, 0xaa, 0xc5, 0x01, 0x00,
-	0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0x5b, 0x40, 0x90, 0xa9, 0x11, 0x9c, 0x98, 0xd8, 0x10, 0x53, 0x1a, 0x56, 0x75, 0x61,
+	0x27, 0xd4, 0xc4, 0x3d, 0xd5, 0xc4, 0xb8, 0x33, 0x65, 0xe7, 0x86, 0x0c, 0xed, 0xb1, 0x34, 0x69,
+	0x3b, 0xcd, 0xcc, 0x50, 0xc5, 0xa5, 0x4f, 0xe0, 0x73, 0xf0, 0x24, 0x2c, 0x59, 0xba, 0xf2, 0xde,
+	0xc0, 0x8b, 0xdc, 0x74, 0xda, 0x92, 0x7b, 0x2f, 0x77, 0x77, 0xe6, 0x7c, 0xbf, 0x6f, 0xe6, 0xfc,
+	0x19, 0x64, 0x81, 0xdc, 0x00, 0xcf, 0x92, 0x5c, 0x12, 0x28, 0x33, 0x52, 0xce, 0x49, 0x0c, 0x39,
+	0x88, 0x44, 0xb8, 0x05, 0x67, 0x92, 0xe1, 0xf1, 0x45, 0x77, 0xa1, 0xcc, 0xdc, 0x72, 0x3e, 0x99,
+	0x5c, 0x39, 0x2a, 0x41, 0xd1, 0x93, 0xd7, 0x31, 0x8b, 0x99, 0x0a, 0x49, 0x15, 0xd5, 0xd9, 0xd9,
+	0xbe, 0x83, 0x5e, 0x7c, 0xa9, 0x6f, 0x5d, 0x4a, 0x2a, 0x01, 0xfb, 0xe8, 0x39, 0x0d, 0x43, 0xb6,
+	0xcd, 0xa5, 0x30, 0x75, 0xbb, 0xeb, 0x18, 0x9e, 0xed, 0x3e, 0x7e, 0xc7, 0x6d, 0x1c, 0x8b, 0x1a,
+	0xf4, 0x7b, 0x87, 0xff, 0x53, 0x2d, 0xb8, 0xf8, 0xf0, 0x47, 0xd4, 0x2f, 0x28, 0xa7, 0x99, 0x30,
+	0x3b, 0xb6, 0xee, 0x18, 0x9e, 0x79, 0x7d, 0xc3, 0x37, 0xa5, 0x37, 0xce, 0x86, 0xc6, 0xef, 0x11,
+	0x8e, 0xa0, 0x48, 0xd9, 0x0e, 0xf8, 0x8a, 0xa6, 0x29, 0xfb, 0x99, 0x26, 0x42, 0x9a, 0x5d, 0xbb,
+	0xeb, 0x0c, 0x83, 0x57, 0xad, 0xb2, 0x68, 0x05, 0xfc, 0x0e, 0x8d, 0x7f, 0x70, 0xf6, 0x1b, 0xf2,
+	0x55, 0xc8, 0x72, 0xc9, 0x69, 0x28, 0x85, 0xd9, 0x53, 0xf0, 0xa8, 0xce, 0x7f, 0x6a, 0xd3, 0xf8,
+	0x33, 0x32, 0x0a, 0x0e, 0x49, 0x2e, 0x24, 0x4d, 0x53, 0x61, 0x3e, 0x53, 0x8d, 0xbd, 0x7d, 0xa2,
+	0xac, 0x0b, 0xd4, 0x94, 0x76, 0xdf, 0x36, 0xfb, 0xa3, 0xa3, 0x97, 0x0f, 0x5b, 0xc7, 0x26, 0x1a,
+	0xd0, 0x28, 0xe2, 0x20, 0xaa, 0x69, 0xe9, 0xce, 0x30, 0x68, 0x8f, 0x18, 0xa3, 0x5e, 0xc8, 0x22,
+	0x50, 0x23, 0x18, 0x06, 0x2a, 0xc6, 0x3e, 0x1a, 0x08, 0xc9, 0x38, 0x8d, 0x41, 0x75, 0x65, 0x78,
+	0x6f, 0xae, 0x4b, 0x50, 0x6b, 0xf0, 0x47, 0xd5, 0xeb, 0xfb, 0x9b, 0xe9, 0x60, 0x59, 0xf3, 0x41,
+	0x6b, 0xf4, 0xbf, 0x1e, 0x4e, 0x96, 0x7e, 0x3c, 0x59, 0xfa, 0xed, 0xc9, 0xd2, 0xff, 0x9e, 0x2d,
+	0xed, 0x78, 0xb6, 0xb4, 0x7f, 0x67, 0x4b, 0xfb, 0x4e, 0xe2, 0x44, 0x6e, 0xb6, 0x6b, 0x37, 0x64,
+	0x19, 0x11, 0xc0, 0x4b, 0xb5, 0xe1, 0x90, 0xa5, 0x8c, 0xc7, 0xea, 0x4c, 0xca, 0xb9, 0x47, 0x7e,
+	0xa9, 0x9f, 0x21, 0x77, 0x05, 0x88, 0x75, 0x5f, 0x11, 0x1f, 0xee, 0x06, 0x00, 0xa1, 0x28, 0x58,
+	0xf8, 0x69, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +228,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Preinstalls) > 0 {
+		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preinstalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenContracts[iNdEx])
+			copy(dAtA[i:], m.FrozenContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeployerAllowlist) > 0 {
+		for iNdEx := len(m.DeployerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeployerAllowlist[iNdEx])
+			copy(dAtA[i:], m.DeployerAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeployerAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -298,6 +363,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeployerAllowlist) > 0 {
+		for _, s := range m.DeployerAllowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenContracts) > 0 {
+		for _, s := range m.FrozenContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Preinstalls) > 0 {
+		for _, e := range m.Preinstalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -426,6 +509,104 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAllowlist = append(m.DeployerAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenContracts = append(m.FrozenContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
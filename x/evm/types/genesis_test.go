@@ -211,6 +211,75 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid contract permissions",
+			genState: &GenesisState{
+				Params:            DefaultParams(),
+				DeployerAllowlist: []string{suite.address},
+				FrozenContracts:   []string{suite.address},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid deployer allowlist",
+			genState: &GenesisState{
+				Params:            DefaultParams(),
+				DeployerAllowlist: []string{"123456"},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated frozen contract",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FrozenContracts: []string{suite.address, suite.address},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid preinstalls",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Preinstalls: []Preinstall{
+					{Name: "test", Address: suite.address, Code: suite.code},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "preinstall without code",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Preinstalls: []Preinstall{
+					{Name: "test", Address: suite.address},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated preinstall",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Preinstalls: []Preinstall{
+					{Name: "test", Address: suite.address, Code: suite.code},
+					{Name: "test", Address: suite.address, Code: suite.code},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "preinstall at a genesis account",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Accounts: []GenesisAccount{
+					{Address: suite.address, Code: suite.code},
+				},
+				Preinstalls: []Preinstall{
+					{Name: "test", Address: suite.address, Code: suite.code},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
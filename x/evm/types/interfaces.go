@@ -10,8 +10,11 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	feemarkettypes "github.com/servprotocolorg/serv/v12/x/feemarket/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 // AccountKeeper defines the expected account keeper interface
@@ -59,6 +62,14 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// StatefulPrecompiledContract defines a precompiled contract which can be registered on the EVM keeper.
+// It's available to the EVM at its fixed address once enabled through the ActivePrecompiles parameter.
+type StatefulPrecompiledContract interface {
+	vm.StatefulPrecompiledContract
+	// Address returns the address the precompiled contract is registered at
+	Address() common.Address
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
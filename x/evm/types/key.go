@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -26,6 +27,10 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockRandom
+	prefixBlockHash
+	prefixDeployerAllowlist
+	prefixFrozenContract
 )
 
 // prefix bytes for the EVM transient store
@@ -41,6 +46,15 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	// KeyPrefixBlockRandom is the key of the randomness of the current block
+	KeyPrefixBlockRandom = []byte{prefixBlockRandom}
+	// KeyPrefixBlockHash is the prefix of the block hash ring buffer
+	KeyPrefixBlockHash = []byte{prefixBlockHash}
+	// KeyPrefixDeployerAllowlist is the prefix of the addresses allowed to deploy contracts
+	KeyPrefixDeployerAllowlist = []byte{prefixDeployerAllowlist}
+	// KeyPrefixFrozenContract is the prefix of the addresses of the frozen contracts
+	KeyPrefixFrozenContract = []byte{prefixFrozenContract}
 )
 
 // Transient Store key prefixes
@@ -60,3 +74,19 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// BlockHashKey returns the key of the slot of the block hash ring buffer storing the hash of the
+// block at the given height.
+func BlockHashKey(height uint64) []byte {
+	return append(KeyPrefixBlockHash, sdk.Uint64ToBigEndian(height%BlockHashHistoryServeWindow)...)
+}
+
+// DeployerAllowlistKey returns the key of an address of the deployer allowlist.
+func DeployerAllowlistKey(address common.Address) []byte {
+	return append(KeyPrefixDeployerAllowlist, address.Bytes()...)
+}
+
+// FrozenContractKey returns the key of the address of a frozen contract.
+func FrozenContractKey(address common.Address) []byte {
+	return append(KeyPrefixFrozenContract, address.Bytes()...)
+}
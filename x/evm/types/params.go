@@ -5,10 +5,12 @@ import (
 	"github.com/servprotocolorg/serv/v12/constants"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 var (
@@ -22,10 +24,18 @@ var (
 	DefaultEnableCall = true
 	// DefaultExtraEIPs defines the list of all EIPs that are enabled by default
 	DefaultExtraEIPs = []int64{3855}
+	// DefaultActivePrecompiles defines the stateful precompiled contracts enabled by default
+	DefaultActivePrecompiles = AvailablePrecompiles()
 )
 
 // NewParams creates a new Params instance
-func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
+func NewParams(
+	evmDenom string,
+	allowUnprotectedTxs, enableCreate, enableCall bool,
+	config ChainConfig,
+	extraEIPs []int64,
+	activePrecompiles ...string,
+) Params {
 	return Params{
 		EvmDenom:            evmDenom,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
@@ -33,6 +43,7 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		ActivePrecompiles:   activePrecompiles,
 	}
 }
 
@@ -46,6 +57,7 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           DefaultExtraEIPs,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		ActivePrecompiles:   DefaultActivePrecompiles,
 	}
 }
 
@@ -71,6 +83,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -115,6 +131,46 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+		addr := common.HexToAddress(precompile)
+		if seen[addr] {
+			return fmt.Errorf("duplicate precompile %s", precompile)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// ActivePrecompileAddresses returns the ActivePrecompiles as a slice of addresses
+func (p Params) ActivePrecompileAddresses() []common.Address {
+	addrs := make([]common.Address, len(p.ActivePrecompiles))
+	for i, precompile := range p.ActivePrecompiles {
+		addrs[i] = common.HexToAddress(precompile)
+	}
+	return addrs
+}
+
+// IsActivePrecompile returns true if the precompiled contract at the given address is active
+func (p Params) IsActivePrecompile(addr common.Address) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
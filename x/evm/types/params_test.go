@@ -41,6 +41,24 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid active precompiles",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, "0x0000000000000000000000000000000000000800"),
+			false,
+		},
+		{
+			"invalid active precompile address",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, "0x800"),
+			true,
+		},
+		{
+			"duplicate active precompile",
+			NewParams(
+				"ara", false, true, true, DefaultChainConfig(), extraEips,
+				"0x0000000000000000000000000000000000000800", "0x0000000000000000000000000000000000000800",
+			),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -69,6 +87,8 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.Error(t, validatePrecompiles(""))
+	require.NoError(t, validatePrecompiles([]string{"0x0000000000000000000000000000000000000800"}))
 }
 
 func TestValidateChainConfig(t *testing.T) {
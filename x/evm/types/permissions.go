@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HexToAddresses returns the hex addresses of a deployer allowlist or a list of frozen contracts as
+// a slice of addresses.
+func HexToAddresses(hexAddrs []string) []common.Address {
+	addrs := make([]common.Address, len(hexAddrs))
+	for i, hexAddr := range hexAddrs {
+		addrs[i] = common.HexToAddress(hexAddr)
+	}
+	return addrs
+}
+
+// AddressesToHex returns the addresses of a deployer allowlist or a list of frozen contracts as a
+// slice of hex addresses.
+func AddressesToHex(addrs []common.Address) []string {
+	hexAddrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		hexAddrs[i] = addr.Hex()
+	}
+	return hexAddrs
+}
+
+// validatePermissionAddresses checks that the addresses of a deployer allowlist or a list of
+// frozen contracts are valid hex addresses without duplicates.
+func validatePermissionAddresses(hexAddrs []string) error {
+	seen := make(map[common.Address]bool, len(hexAddrs))
+	for _, hexAddr := range hexAddrs {
+		if !common.IsHexAddress(hexAddr) {
+			return fmt.Errorf("invalid address %s", hexAddr)
+		}
+		addr := common.HexToAddress(hexAddr)
+		if seen[addr] {
+			return fmt.Errorf("duplicate address %s", hexAddr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common"
+
+// Addresses of the built-in stateful precompiled contracts wrapping the Cosmos SDK modules.
+var (
+	StakingPrecompileAddress      = common.HexToAddress("0x0000000000000000000000000000000000000800")
+	DistributionPrecompileAddress = common.HexToAddress("0x0000000000000000000000000000000000000801")
+	ICS20PrecompileAddress        = common.HexToAddress("0x0000000000000000000000000000000000000802")
+	BankPrecompileAddress         = common.HexToAddress("0x0000000000000000000000000000000000000804")
+
+	// BlockHashHistoryAddress is the address of the EIP-2935 style system contract serving the
+	// hashes of the last BlockHashHistoryServeWindow blocks, it's optional and not active by default.
+	BlockHashHistoryAddress = common.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935")
+)
+
+const (
+	// BlockHashHistorySize is the number of the last block hashes kept by the evm module, it's
+	// the window of the BLOCKHASH opcode.
+	BlockHashHistorySize = 256
+	// BlockHashHistoryServeWindow is the number of the last block hashes kept while the block hash
+	// history contract is active, it's the EIP-2935 HISTORY_SERVE_WINDOW.
+	BlockHashHistoryServeWindow = 8191
+)
+
+// AvailablePrecompiles returns the hex addresses of the built-in stateful precompiled contracts.
+func AvailablePrecompiles() []string {
+	return []string{
+		StakingPrecompileAddress.Hex(),
+		DistributionPrecompileAddress.Hex(),
+		ICS20PrecompileAddress.Hex(),
+		BankPrecompileAddress.Hex(),
+	}
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	_ "embed" // embed the preinstalled contracts
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/servprotocolorg/serv/v12/types"
+)
+
+var (
+	//go:embed preinstalls.json
+	preinstallsJSON []byte
+
+	// DefaultPreinstalls are the canonical contracts placed at their usual addresses by the default
+	// genesis state:
+	//   - the CREATE2 deterministic deployment proxy
+	//   - Multicall3
+	//   - Permit2, its runtime code caches the domain separator of Ethereum mainnet, which is
+	//     recomputed on other chain ids
+	//   - the Safe singleton factory and the Safe v1.3.0 singletons at the addresses of their
+	//     deployment through the singleton factory. The threshold of the singletons is set to 1
+	//     like their constructor does, so that they can't be set up and destroyed.
+	DefaultPreinstalls []Preinstall
+)
+
+func init() {
+	if err := json.Unmarshal(preinstallsJSON, &DefaultPreinstalls); err != nil {
+		panic(err)
+	}
+
+	if len(DefaultPreinstalls) == 0 {
+		panic("load preinstalls failed")
+	}
+}
+
+// Validate performs a basic validation of the Preinstall fields.
+func (p Preinstall) Validate() error {
+	if err := types.ValidateNonZeroAddress(p.Address); err != nil {
+		return err
+	}
+
+	code, err := hex.DecodeString(strings.TrimPrefix(p.Code, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid code: %w", err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("empty code")
+	}
+
+	return p.Storage.Validate()
+}
+
+// GetCodeBytes returns the runtime code of the preinstall.
+func (p Preinstall) GetCodeBytes() []byte {
+	return common.FromHex(p.Code)
+}
+
+// validatePreinstalls checks the preinstalls and that their addresses are unique.
+func validatePreinstalls(preinstalls []Preinstall) error {
+	seen := make(map[common.Address]bool, len(preinstalls))
+	for _, preinstall := range preinstalls {
+		if err := preinstall.Validate(); err != nil {
+			return fmt.Errorf("invalid preinstall %s: %w", preinstall.Name, err)
+		}
+		addr := common.HexToAddress(preinstall.Address)
+		if seen[addr] {
+			return fmt.Errorf("duplicate preinstall address %s", preinstall.Address)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/servprotocolorg/serv/v12/types"
+)
+
+// The eth_getProof proofs are the Merkle proofs of the keys of the application multistore, they
+// prove the value or the absence of a key against the app hash of a block.
+//
+// A proof is the list of the hex encoded protobuf tendermint.crypto.ProofOp operations, ordered
+// from the key to the app hash:
+//   - the ICS-23 commitment proof ("ics23:iavl") of the key in the IAVL store of the module
+//   - the ICS-23 commitment proof ("ics23:simple") of the store root in the multistore
+//
+// The storage slots are proven in the evm store with the StateKey(address, slot) key, the value is
+// the 32 bytes slot value. The zero slots are either absent from the store or, if they were cleared
+// by the EVM, stored with a 32 zero bytes value. The accounts are proven in
+// the auth store with the AddressStoreKey(address) key, the value is the protobuf Any of the
+// account, it contains the nonce and the code hash of the account but not its balance. The balances
+// are proven in the bank store with the CreatePrefixedAccountStoreKey(address, denom) key of the EVM
+// denomination, the value is the encoded amount and the zero balances are absent from the store.
+//
+// The state of the height H is committed by the app hash of the header of the block H+1.
+
+// EncodeProof encodes the proof operations to the eth_getProof proof format.
+func EncodeProof(proof *crypto.ProofOps) []string {
+	if proof == nil {
+		return []string{}
+	}
+
+	encoded := make([]string, len(proof.Ops))
+	for i, op := range proof.Ops {
+		bz, err := op.Marshal()
+		if err != nil {
+			// the proof operations are always marshalable
+			panic(err)
+		}
+		encoded[i] = hexutil.Encode(bz)
+	}
+	return encoded
+}
+
+// DecodeProof decodes the proof operations of the eth_getProof proof format.
+func DecodeProof(proof []string) (*crypto.ProofOps, error) {
+	if len(proof) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "empty proof")
+	}
+
+	ops := &crypto.ProofOps{Ops: make([]crypto.ProofOp, len(proof))}
+	for i, encoded := range proof {
+		bz, err := hexutil.Decode(encoded)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "invalid proof operation %d: %s", i, err)
+		}
+		if err := ops.Ops[i].Unmarshal(bz); err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "invalid proof operation %d: %s", i, err)
+		}
+	}
+	return ops, nil
+}
+
+// VerifyStorageProof verifies the proof of the value of the storage slot of the contract against
+// the app hash, the zero value is verified by the proof of the absence of the slot or by the proof
+// of its 32 zero bytes value.
+func VerifyStorageProof(appHash []byte, address common.Address, key, value common.Hash, proof []string) error {
+	ops, err := DecodeProof(proof)
+	if err != nil {
+		return err
+	}
+
+	keyPath := proofKeyPath(StoreKey, StateKey(address, key.Bytes()))
+	if value == (common.Hash{}) && !isExistenceProof(ops) {
+		err = rootmulti.DefaultProofRuntime().VerifyAbsence(ops, appHash, keyPath)
+	} else {
+		err = rootmulti.DefaultProofRuntime().VerifyValue(ops, appHash, keyPath, value.Bytes())
+	}
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "storage slot %s of %s: %s", key, address, err)
+	}
+	return nil
+}
+
+// VerifyAccountProof verifies the proof of the nonce and the code hash of the account against the
+// app hash. The account is absent from the auth store if it has no nonce and no code.
+func VerifyAccountProof(appHash []byte, address common.Address, nonce uint64, codeHash common.Hash, proof []string) error {
+	ops, err := DecodeProof(proof)
+	if err != nil {
+		return err
+	}
+
+	op, err := storetypes.CommitmentOpDecoder(ops.Ops[0])
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "account %s: %s", address, err)
+	}
+	exist := op.(storetypes.CommitmentOp).Proof.GetExist()
+
+	keyPath := proofKeyPath(authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes())))
+	if exist == nil {
+		if err := rootmulti.DefaultProofRuntime().VerifyAbsence(ops, appHash, keyPath); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "account %s: %s", address, err)
+		}
+		if nonce != 0 || !isEmptyCodeHash(codeHash) {
+			return errorsmod.Wrapf(ErrInvalidProof, "account %s doesn't exist", address)
+		}
+		return nil
+	}
+
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(ops, appHash, keyPath, exist.Value); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "account %s: %s", address, err)
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	var account authtypes.AccountI
+	if err := codec.NewProtoCodec(registry).UnmarshalInterface(exist.Value, &account); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "account %s: %s", address, err)
+	}
+
+	provenCodeHash := common.BytesToHash(EmptyCodeHash)
+	if ethAccount, ok := account.(types.EthAccountI); ok {
+		provenCodeHash = ethAccount.GetCodeHash()
+	}
+
+	if account.GetSequence() != nonce {
+		return errorsmod.Wrapf(ErrInvalidProof, "account %s nonce mismatch, expected %d, got %d", address, nonce, account.GetSequence())
+	}
+	if provenCodeHash != codeHash && !(isEmptyCodeHash(provenCodeHash) && isEmptyCodeHash(codeHash)) {
+		return errorsmod.Wrapf(ErrInvalidProof, "account %s code hash mismatch, expected %s, got %s", address, codeHash, provenCodeHash)
+	}
+	return nil
+}
+
+// VerifyBalanceProof verifies the proof of the balance of the account in the EVM denomination
+// against the app hash, the zero balance is verified by the proof of the absence of the balance.
+func VerifyBalanceProof(appHash []byte, address common.Address, denom string, balance *big.Int, proof []string) error {
+	ops, err := DecodeProof(proof)
+	if err != nil {
+		return err
+	}
+
+	keyPath := proofKeyPath(banktypes.StoreKey, banktypes.CreatePrefixedAccountStoreKey(address.Bytes(), []byte(denom)))
+	if !isExistenceProof(ops) {
+		if err := rootmulti.DefaultProofRuntime().VerifyAbsence(ops, appHash, keyPath); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "balance of %s: %s", address, err)
+		}
+		if balance.Sign() != 0 {
+			return errorsmod.Wrapf(ErrInvalidProof, "balance of %s is zero", address)
+		}
+		return nil
+	}
+
+	op, err := storetypes.CommitmentOpDecoder(ops.Ops[0])
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "balance of %s: %s", address, err)
+	}
+	value := op.(storetypes.CommitmentOp).Proof.GetExist().Value
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(ops, appHash, keyPath, value); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "balance of %s: %s", address, err)
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(value); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "balance of %s: %s", address, err)
+	}
+	if amount.BigInt().Cmp(balance) != 0 {
+		return errorsmod.Wrapf(ErrInvalidProof, "balance of %s mismatch, expected %s, got %s", address, balance, amount)
+	}
+	return nil
+}
+
+// isExistenceProof returns true if the proof is an existence proof of the key in the module store,
+// the invalid proofs are left to the verification.
+func isExistenceProof(ops *crypto.ProofOps) bool {
+	op, err := storetypes.CommitmentOpDecoder(ops.Ops[0])
+	if err != nil {
+		return false
+	}
+	return op.(storetypes.CommitmentOp).Proof.GetExist() != nil
+}
+
+// proofKeyPath returns the key path of the key of the store in the multistore.
+func proofKeyPath(storeKey string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// isEmptyCodeHash returns true if the code hash is the hash of the empty code or the zero hash.
+func isEmptyCodeHash(codeHash common.Hash) bool {
+	return codeHash == (common.Hash{}) || bytes.Equal(codeHash.Bytes(), EmptyCodeHash)
+}
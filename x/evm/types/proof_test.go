@@ -0,0 +1,320 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/servprotocolorg/serv/v12/testutil/tx"
+	"github.com/servprotocolorg/serv/v12/types"
+	evmtypes "github.com/servprotocolorg/serv/v12/x/evm/types"
+)
+
+func TestEncodeProof(t *testing.T) {
+	proofData := []byte("\n\031\n\003KEY\022\005VALUE\032\013\010\001\030\001 \001*\003\000\002\002")
+	testCases := []struct {
+		name  string
+		proof *crypto.ProofOps
+		exp   []string
+	}{
+		{
+			"no proof provided",
+			nil,
+			[]string{},
+		},
+		{
+			"no proof data provided",
+			&crypto.ProofOps{Ops: []crypto.ProofOp{{Type: "ics23:iavl"}}},
+			[]string{"0x0a0a69637332333a6961766c"},
+		},
+		{
+			"valid proof provided",
+			&crypto.ProofOps{Ops: []crypto.ProofOp{{Type: "ics23:iavl", Key: []byte("KEY"), Data: proofData}}},
+			[]string{"0x0a0a69637332333a6961766c12034b45591a1b0a190a034b4559120556414c55451a0b0801180120012a03000202"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded := evmtypes.EncodeProof(tc.proof)
+			require.Equal(t, tc.exp, encoded)
+			if tc.proof == nil {
+				return
+			}
+
+			decoded, err := evmtypes.DecodeProof(encoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.proof, decoded)
+		})
+	}
+}
+
+func TestDecodeProofInvalid(t *testing.T) {
+	_, err := evmtypes.DecodeProof(nil)
+	require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+	_, err = evmtypes.DecodeProof([]string{"0xzz"})
+	require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+	_, err = evmtypes.DecodeProof([]string{"0xff"})
+	require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+}
+
+// proofStore is a multistore with the auth, bank and evm stores, it records the app hash of each
+// committed height.
+type proofStore struct {
+	t         *testing.T
+	store     *rootmulti.Store
+	cdc       codec.Codec
+	evmKey    *storetypes.KVStoreKey
+	authKey   *storetypes.KVStoreKey
+	bankKey   *storetypes.KVStoreKey
+	appHashes map[int64][]byte
+}
+
+func newProofStore(t *testing.T) *proofStore {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	ps := &proofStore{
+		t:         t,
+		store:     rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger()),
+		cdc:       codec.NewProtoCodec(registry),
+		evmKey:    storetypes.NewKVStoreKey(evmtypes.StoreKey),
+		authKey:   storetypes.NewKVStoreKey(authtypes.StoreKey),
+		bankKey:   storetypes.NewKVStoreKey(banktypes.StoreKey),
+		appHashes: make(map[int64][]byte),
+	}
+	ps.store.MountStoreWithDB(ps.evmKey, storetypes.StoreTypeIAVL, nil)
+	ps.store.MountStoreWithDB(ps.authKey, storetypes.StoreTypeIAVL, nil)
+	ps.store.MountStoreWithDB(ps.bankKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ps.store.LoadLatestVersion())
+	return ps
+}
+
+func (ps *proofStore) setState(address common.Address, key, value common.Hash) {
+	store := ps.store.GetCommitKVStore(ps.evmKey)
+	if value == (common.Hash{}) {
+		store.Delete(evmtypes.StateKey(address, key.Bytes()))
+		return
+	}
+	store.Set(evmtypes.StateKey(address, key.Bytes()), value.Bytes())
+}
+
+func (ps *proofStore) setAccount(account authtypes.AccountI) {
+	bz, err := ps.cdc.MarshalInterface(account)
+	require.NoError(ps.t, err)
+	ps.store.GetCommitKVStore(ps.authKey).Set(authtypes.AddressStoreKey(account.GetAddress()), bz)
+}
+
+func (ps *proofStore) setBalance(address common.Address, denom string, amount int64) {
+	store := ps.store.GetCommitKVStore(ps.bankKey)
+	key := banktypes.CreatePrefixedAccountStoreKey(address.Bytes(), []byte(denom))
+	if amount == 0 {
+		store.Delete(key)
+		return
+	}
+	bz, err := sdkmath.NewInt(amount).Marshal()
+	require.NoError(ps.t, err)
+	store.Set(key, bz)
+}
+
+func (ps *proofStore) commit() int64 {
+	commitID := ps.store.Commit()
+	ps.appHashes[commitID.Version] = commitID.Hash
+	return commitID.Version
+}
+
+// proof queries the proof of the key of the store at the height, like the eth_getProof ABCI query.
+func (ps *proofStore) proof(storeKey string, key []byte, height int64) []string {
+	res := ps.store.Query(abci.RequestQuery{
+		Path:   "/" + storeKey + "/key",
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	require.Zero(ps.t, res.Code, res.Log)
+	return evmtypes.EncodeProof(res.ProofOps)
+}
+
+func TestVerifyStorageProof(t *testing.T) {
+	ps := newProofStore(t)
+	contract := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	key := common.HexToHash("0x01")
+	value1 := common.HexToHash("0x0a")
+	value2 := common.HexToHash("0x0b")
+
+	ps.setState(contract, key, value1)
+	height1 := ps.commit()
+	ps.setState(contract, key, value2)
+	ps.setState(other, key, value1)
+	height2 := ps.commit()
+	ps.setState(contract, key, common.Hash{})
+	// the slots cleared by the EVM are stored with a zero value
+	ps.store.GetCommitKVStore(ps.evmKey).Set(evmtypes.StateKey(other, key.Bytes()), common.Hash{}.Bytes())
+	height3 := ps.commit()
+
+	testCases := []struct {
+		name    string
+		height  int64
+		address common.Address
+		value   common.Hash
+		expPass bool
+	}{
+		{"value at the first height", height1, contract, value1, true},
+		{"value at the second height", height2, contract, value2, true},
+		{"deleted value", height3, contract, common.Hash{}, true},
+		{"absent value", height1, other, common.Hash{}, true},
+		{"value set at a later height", height2, other, value1, true},
+		{"historical value at a later height", height2, contract, value1, false},
+		{"value of a deleted slot", height3, contract, value2, false},
+		{"zero value of an existing slot", height1, contract, common.Hash{}, false},
+		{"zero value of a cleared slot", height3, other, common.Hash{}, true},
+		{"value of a cleared slot", height3, other, value1, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proof := ps.proof(evmtypes.StoreKey, evmtypes.StateKey(tc.address, key.Bytes()), tc.height)
+			err := evmtypes.VerifyStorageProof(ps.appHashes[tc.height], tc.address, key, tc.value, proof)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+			}
+		})
+	}
+
+	t.Run("app hash of another height", func(t *testing.T) {
+		proof := ps.proof(evmtypes.StoreKey, evmtypes.StateKey(contract, key.Bytes()), height1)
+		err := evmtypes.VerifyStorageProof(ps.appHashes[height2], contract, key, value1, proof)
+		require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+	})
+
+	t.Run("proof of another slot", func(t *testing.T) {
+		proof := ps.proof(evmtypes.StoreKey, evmtypes.StateKey(other, key.Bytes()), height2)
+		err := evmtypes.VerifyStorageProof(ps.appHashes[height2], contract, key, value1, proof)
+		require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+	})
+}
+
+func TestVerifyAccountProof(t *testing.T) {
+	ps := newProofStore(t)
+	eoa := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	absent := utiltx.GenerateAddress()
+	codeHash := common.HexToHash("0x1234")
+	emptyCodeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(eoa.Bytes()))
+	require.NoError(t, baseAccount.SetSequence(1))
+	ps.setAccount(baseAccount)
+	ethAccount := &types.EthAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(sdk.AccAddress(contract.Bytes())),
+		CodeHash:    codeHash.Hex(),
+	}
+	require.NoError(t, ethAccount.SetSequence(1))
+	ps.setAccount(ethAccount)
+	height1 := ps.commit()
+
+	require.NoError(t, baseAccount.SetSequence(2))
+	ps.setAccount(baseAccount)
+	height2 := ps.commit()
+
+	testCases := []struct {
+		name     string
+		height   int64
+		address  common.Address
+		nonce    uint64
+		codeHash common.Hash
+		expPass  bool
+	}{
+		{"base account", height1, eoa, 1, emptyCodeHash, true},
+		{"base account with zero code hash", height1, eoa, 1, common.Hash{}, true},
+		{"base account at a later height", height2, eoa, 2, emptyCodeHash, true},
+		{"eth account", height1, contract, 1, codeHash, true},
+		{"absent account", height1, absent, 0, emptyCodeHash, true},
+		{"historical nonce at a later height", height2, eoa, 1, emptyCodeHash, false},
+		{"code hash mismatch", height1, contract, 1, emptyCodeHash, false},
+		{"nonce of an absent account", height1, absent, 1, emptyCodeHash, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proof := ps.proof(authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(tc.address.Bytes())), tc.height)
+			err := evmtypes.VerifyAccountProof(ps.appHashes[tc.height], tc.address, tc.nonce, tc.codeHash, proof)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+			}
+		})
+	}
+
+	t.Run("proof of another account", func(t *testing.T) {
+		proof := ps.proof(authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(eoa.Bytes())), height1)
+		err := evmtypes.VerifyAccountProof(ps.appHashes[height1], contract, 1, emptyCodeHash, proof)
+		require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+	})
+}
+
+func TestVerifyBalanceProof(t *testing.T) {
+	ps := newProofStore(t)
+	holder := utiltx.GenerateAddress()
+	spender := utiltx.GenerateAddress()
+	absent := utiltx.GenerateAddress()
+	denom := evmtypes.DefaultEVMDenom
+
+	ps.setBalance(holder, denom, 100)
+	ps.setBalance(holder, "other", 50)
+	ps.setBalance(spender, denom, 10)
+	height1 := ps.commit()
+
+	ps.setBalance(spender, denom, 0)
+	height2 := ps.commit()
+
+	testCases := []struct {
+		name    string
+		height  int64
+		address common.Address
+		denom   string
+		balance int64
+		expPass bool
+	}{
+		{"balance", height1, holder, denom, 100, true},
+		{"balance of another denom", height1, holder, "other", 50, true},
+		{"spent balance", height2, spender, denom, 0, true},
+		{"absent balance", height1, absent, denom, 0, true},
+		{"balance mismatch", height1, holder, denom, 50, false},
+		{"historical balance at a later height", height2, spender, denom, 10, false},
+		{"balance of an absent account", height1, absent, denom, 1, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proof := ps.proof(banktypes.StoreKey, banktypes.CreatePrefixedAccountStoreKey(tc.address.Bytes(), []byte(tc.denom)), tc.height)
+			err := evmtypes.VerifyBalanceProof(ps.appHashes[tc.height], tc.address, tc.denom, big.NewInt(tc.balance), proof)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+			}
+		})
+	}
+
+	t.Run("proof of another denom", func(t *testing.T) {
+		proof := ps.proof(banktypes.StoreKey, banktypes.CreatePrefixedAccountStoreKey(holder.Bytes(), []byte("other")), height1)
+		err := evmtypes.VerifyBalanceProof(ps.appHashes[height1], holder, denom, big.NewInt(50), proof)
+		require.ErrorIs(t, err, evmtypes.ErrInvalidProof)
+	})
+}
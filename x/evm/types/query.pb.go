@@ -786,6 +786,101 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryContractPermissionsRequest defines the request type for querying the deployer allowlist
+// and the frozen contracts.
+type QueryContractPermissionsRequest struct {
+}
+
+func (m *QueryContractPermissionsRequest) Reset()         { *m = QueryContractPermissionsRequest{} }
+func (m *QueryContractPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractPermissionsRequest) ProtoMessage()    {}
+func (*QueryContractPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{16}
+}
+func (m *QueryContractPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractPermissionsRequest.Merge(m, src)
+}
+func (m *QueryContractPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractPermissionsRequest proto.InternalMessageInfo
+
+// QueryContractPermissionsResponse defines the response type for querying the deployer allowlist
+// and the frozen contracts.
+type QueryContractPermissionsResponse struct {
+	// deployer_allowlist defines the hex addresses allowed to deploy contracts, an empty list allows
+	// every address to deploy contracts.
+	DeployerAllowlist []string `protobuf:"bytes,1,rep,name=deployer_allowlist,json=deployerAllowlist,proto3" json:"deployer_allowlist,omitempty"`
+	// frozen_contracts defines the hex addresses of the contracts rejecting all calls.
+	FrozenContracts []string `protobuf:"bytes,2,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts,omitempty"`
+}
+
+func (m *QueryContractPermissionsResponse) Reset()         { *m = QueryContractPermissionsResponse{} }
+func (m *QueryContractPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractPermissionsResponse) ProtoMessage()    {}
+func (*QueryContractPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{17}
+}
+func (m *QueryContractPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractPermissionsResponse.Merge(m, src)
+}
+func (m *QueryContractPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryContractPermissionsResponse) GetDeployerAllowlist() []string {
+	if m != nil {
+		return m.DeployerAllowlist
+	}
+	return nil
+}
+
+func (m *QueryContractPermissionsResponse) GetFrozenContracts() []string {
+	if m != nil {
+		return m.FrozenContracts
+	}
+	return nil
+}
+
 // EthCallRequest defines EthCall request
 type EthCallRequest struct {
 	// args uses the same json format as the json rpc api.
@@ -796,13 +891,16 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the state overrides applied before executing the call, it
+	// uses the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{16}
+	return fileDescriptor_e15a877459347994, []int{18}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,6 +957,13 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -869,7 +974,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{17}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1037,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{20}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1139,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{21}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,7 +1199,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,7 +1294,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,6 +1330,109 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request, the call is traced on top of the
+// state of the requested block.
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the state overrides applied before executing the call, it
+	// uses the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the block header fields overridden when executing the
+	// call, it uses the same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,7,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1234,7 +1442,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1481,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1319,12 +1527,15 @@ func init() {
 	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryContractPermissionsRequest)(nil), "ethermint.evm.v1.QueryContractPermissionsRequest")
+	proto.RegisterType((*QueryContractPermissionsResponse)(nil), "ethermint.evm.v1.QueryContractPermissionsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 }
@@ -1332,99 +1543,110 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x3c, 0x27, 0xe0, 0xef, 0x10, 0xc0, 0x59, 0x12, 0x3b, 0xec, 0xb7,
-	0x89, 0xcd, 0xaf, 0x5d, 0xe2, 0x4a, 0x48, 0xed, 0xa5, 0xc5, 0x96, 0xa1, 0x14, 0xa8, 0xa8, 0x1b,
-	0xf5, 0x50, 0x09, 0x59, 0xe3, 0xf5, 0xb0, 0xb6, 0x62, 0xef, 0x9a, 0x9d, 0xb1, 0xe5, 0x80, 0x38,
-	0x14, 0xa1, 0xfe, 0x50, 0x2f, 0x48, 0xbd, 0xf5, 0xc4, 0xbd, 0xb7, 0x5e, 0x7a, 0xec, 0x95, 0x23,
-	0x52, 0x2f, 0x55, 0x0f, 0xb4, 0x22, 0x3d, 0xf4, 0x6f, 0xe8, 0xa9, 0x9a, 0xd9, 0x59, 0x7b, 0xd7,
-	0xf6, 0xc6, 0xa1, 0xa2, 0xb7, 0x9e, 0xbc, 0x33, 0xf3, 0xe6, 0x7d, 0x3e, 0xf3, 0xde, 0x9b, 0x79,
-	0x1f, 0x19, 0xd6, 0x09, 0x6b, 0x12, 0xb7, 0xd3, 0xb2, 0x99, 0x41, 0xfa, 0x1d, 0xa3, 0xbf, 0x63,
-	0xdc, 0xef, 0x11, 0x77, 0x5f, 0xef, 0xba, 0x0e, 0x73, 0x50, 0x7a, 0xb8, 0xaa, 0x93, 0x7e, 0x47,
-	0xef, 0xef, 0xa8, 0xe7, 0x4d, 0x87, 0x76, 0x1c, 0x6a, 0xd4, 0x31, 0x25, 0x9e, 0xa9, 0xd1, 0xdf,
-	0xa9, 0x13, 0x86, 0x77, 0x8c, 0x2e, 0xb6, 0x5a, 0x36, 0x66, 0x2d, 0xc7, 0xf6, 0x76, 0xab, 0xea,
-	0x84, 0x6f, 0xee, 0xc4, 0x5b, 0x5b, 0x9b, 0x58, 0x63, 0x03, 0xb9, 0xb4, 0x6a, 0x39, 0x96, 0x23,
-	0x3e, 0x0d, 0xfe, 0x25, 0x67, 0xd7, 0x2d, 0xc7, 0xb1, 0xda, 0xc4, 0xc0, 0xdd, 0x96, 0x81, 0x6d,
-	0xdb, 0x61, 0x02, 0x89, 0xca, 0xd5, 0x9c, 0x5c, 0x15, 0xa3, 0x7a, 0xef, 0x9e, 0xc1, 0x5a, 0x1d,
-	0x42, 0x19, 0xee, 0x74, 0x3d, 0x03, 0xed, 0x1d, 0x38, 0xf1, 0x31, 0x67, 0x7b, 0xd5, 0x34, 0x9d,
-	0x9e, 0xcd, 0xaa, 0xe4, 0x7e, 0x8f, 0x50, 0x86, 0x32, 0x90, 0xc0, 0x8d, 0x86, 0x4b, 0x28, 0xcd,
-	0x28, 0x9b, 0x4a, 0x61, 0xa9, 0xea, 0x0f, 0xdf, 0x4d, 0x7e, 0xf5, 0x2c, 0x37, 0xf7, 0xe7, 0xb3,
-	0xdc, 0x9c, 0x66, 0xc2, 0x6a, 0x78, 0x2b, 0xed, 0x3a, 0x36, 0x25, 0x7c, 0x6f, 0x1d, 0xb7, 0xb1,
-	0x6d, 0x12, 0x7f, 0xaf, 0x1c, 0xa2, 0x33, 0xb0, 0x64, 0x3a, 0x0d, 0x52, 0x6b, 0x62, 0xda, 0xcc,
-	0xcc, 0x8b, 0xb5, 0x24, 0x9f, 0xf8, 0x00, 0xd3, 0x26, 0x5a, 0x85, 0x05, 0xdb, 0xe1, 0x9b, 0x62,
-	0x9b, 0x4a, 0x21, 0x5e, 0xf5, 0x06, 0xda, 0x7b, 0xb0, 0x26, 0x40, 0xca, 0x22, 0xbc, 0xff, 0x80,
-	0xe5, 0x17, 0x0a, 0xa8, 0xd3, 0x3c, 0x48, 0xb2, 0x5b, 0x70, 0xcc, 0xcb, 0x5c, 0x2d, 0xec, 0x69,
-	0xc5, 0x9b, 0xbd, 0xea, 0x4d, 0x22, 0x15, 0x92, 0x94, 0x83, 0x72, 0x7e, 0xf3, 0x82, 0xdf, 0x70,
-	0xcc, 0x5d, 0x60, 0xcf, 0x6b, 0xcd, 0xee, 0x75, 0xea, 0xc4, 0x95, 0x27, 0x58, 0x91, 0xb3, 0x1f,
-	0x89, 0x49, 0xed, 0x26, 0xac, 0x0b, 0x1e, 0x9f, 0xe2, 0x76, 0xab, 0x81, 0x99, 0xe3, 0x8e, 0x1d,
-	0xe6, 0x2c, 0x2c, 0x9b, 0x8e, 0x3d, 0xce, 0x23, 0xc5, 0xe7, 0xae, 0x4e, 0x9c, 0xea, 0x1b, 0x05,
-	0x36, 0x22, 0xbc, 0xc9, 0x83, 0xe5, 0xe1, 0xb8, 0xcf, 0x2a, 0xec, 0xd1, 0x27, 0xfb, 0x06, 0x8f,
-	0xe6, 0x17, 0x51, 0xc9, 0xcb, 0xf3, 0xeb, 0xa4, 0xe7, 0xb2, 0x2c, 0xa2, 0xe1, 0xd6, 0x59, 0x45,
-	0xa4, 0xdd, 0x94, 0x60, 0x9f, 0x30, 0xc7, 0xc5, 0xd6, 0x6c, 0x30, 0x94, 0x86, 0xd8, 0x1e, 0xd9,
-	0x97, 0xf5, 0xc6, 0x3f, 0x03, 0xf0, 0x17, 0x25, 0xfc, 0xd0, 0x99, 0x84, 0x5f, 0x85, 0x85, 0x3e,
-	0x6e, 0xf7, 0x7c, 0x70, 0x6f, 0xa0, 0x5d, 0x81, 0xb4, 0x2c, 0xa5, 0xc6, 0x6b, 0x1d, 0x32, 0x0f,
-	0xff, 0x0b, 0xec, 0x93, 0x10, 0x08, 0xe2, 0xbc, 0xf6, 0xc5, 0xae, 0xe5, 0xaa, 0xf8, 0xd6, 0x1e,
-	0x00, 0x12, 0x86, 0xbb, 0x83, 0x5b, 0x8e, 0x45, 0x7d, 0x08, 0x04, 0x71, 0x71, 0x63, 0x3c, 0xff,
-	0xe2, 0x1b, 0x5d, 0x03, 0x18, 0xbd, 0x2b, 0xe2, 0x6c, 0xa9, 0xe2, 0xb6, 0xee, 0x15, 0xad, 0xce,
-	0x1f, 0x21, 0xdd, 0x7b, 0xaf, 0xe4, 0x23, 0xa4, 0xdf, 0x19, 0x85, 0xaa, 0x1a, 0xd8, 0x19, 0x20,
-	0xf9, 0xb5, 0x22, 0x03, 0xeb, 0x83, 0x4b, 0x9e, 0xe7, 0x20, 0xde, 0x76, 0x2c, 0x7e, 0xba, 0x58,
-	0x21, 0x55, 0x3c, 0xa9, 0x8f, 0x3f, 0x7d, 0xfa, 0x2d, 0xc7, 0xaa, 0x0a, 0x13, 0x74, 0x7d, 0x0a,
-	0xa9, 0xfc, 0x4c, 0x52, 0x1e, 0x4e, 0x90, 0x95, 0xb6, 0x2a, 0xe3, 0x70, 0x07, 0xbb, 0xb8, 0xe3,
-	0xc7, 0x41, 0xbb, 0x2d, 0x09, 0xfa, 0xb3, 0x92, 0xe0, 0x15, 0x58, 0xec, 0x8a, 0x19, 0x11, 0xa0,
-	0x54, 0x31, 0x33, 0x49, 0xd1, 0xdb, 0x51, 0x8a, 0x3f, 0x7f, 0x99, 0x9b, 0xab, 0x4a, 0x6b, 0xed,
-	0x47, 0x05, 0x8e, 0x55, 0x58, 0xb3, 0x8c, 0xdb, 0xed, 0x40, 0xa4, 0xb1, 0x6b, 0x51, 0x3f, 0x27,
-	0xfc, 0x1b, 0x9d, 0x86, 0x84, 0x85, 0x69, 0xcd, 0xc4, 0x5d, 0x79, 0x3d, 0x16, 0x2d, 0x4c, 0xcb,
-	0xb8, 0x8b, 0xee, 0x42, 0xba, 0xeb, 0x3a, 0x5d, 0x87, 0x12, 0x77, 0x78, 0xc5, 0xf8, 0xf5, 0x58,
-	0x2e, 0x15, 0xff, 0x7a, 0x99, 0xd3, 0xad, 0x16, 0x6b, 0xf6, 0xea, 0xba, 0xe9, 0x74, 0x0c, 0xd9,
-	0x1b, 0xbc, 0x9f, 0x4b, 0xb4, 0xb1, 0x67, 0xb0, 0xfd, 0x2e, 0xa1, 0x7a, 0x79, 0x74, 0xb7, 0xab,
-	0xc7, 0x7d, 0x5f, 0xfe, 0xbd, 0x5c, 0x83, 0xa4, 0xd9, 0xc4, 0x2d, 0xbb, 0xd6, 0x6a, 0x64, 0xe2,
-	0x9b, 0x4a, 0x21, 0x56, 0x4d, 0x88, 0xf1, 0x8d, 0x86, 0x96, 0x87, 0x13, 0x15, 0xca, 0x5a, 0x1d,
-	0xcc, 0xc8, 0x75, 0x3c, 0x0a, 0x44, 0x1a, 0x62, 0x16, 0xf6, 0xc8, 0xc7, 0xab, 0xfc, 0x53, 0x7b,
-	0x12, 0xf7, 0x73, 0xea, 0x62, 0x93, 0xec, 0x0e, 0xfc, 0x73, 0xee, 0x40, 0xac, 0x43, 0x2d, 0x19,
-	0xaf, 0xdc, 0x64, 0xbc, 0x6e, 0x53, 0xab, 0xc2, 0xe7, 0x48, 0xaf, 0xb3, 0x3b, 0xa8, 0x72, 0x5b,
-	0xf4, 0x3e, 0x2c, 0x33, 0xee, 0xa4, 0x66, 0x3a, 0xf6, 0xbd, 0x96, 0x25, 0x4e, 0x9a, 0x2a, 0x6e,
-	0x4c, 0xee, 0x15, 0x50, 0x65, 0x61, 0x54, 0x4d, 0xb1, 0xd1, 0x00, 0x95, 0x61, 0xb9, 0xeb, 0x92,
-	0x06, 0x31, 0x09, 0xa5, 0x8e, 0x4b, 0x33, 0x71, 0x51, 0x50, 0x33, 0xd1, 0x43, 0x9b, 0xf8, 0x2b,
-	0x59, 0x6f, 0x3b, 0xe6, 0x9e, 0xff, 0x1e, 0x2d, 0x88, 0xc8, 0xa4, 0xc4, 0x9c, 0xf7, 0x1a, 0xa1,
-	0x0d, 0x00, 0xcf, 0x44, 0x5c, 0x9a, 0x45, 0x71, 0x69, 0x96, 0xc4, 0x8c, 0xe8, 0x33, 0x65, 0x7f,
-	0x99, 0xb7, 0xc2, 0x4c, 0x42, 0x1c, 0x43, 0xd5, 0xbd, 0x3e, 0xa9, 0xfb, 0x7d, 0x52, 0xdf, 0xf5,
-	0xfb, 0x64, 0x29, 0xc9, 0x8b, 0xe6, 0xe9, 0x6f, 0x39, 0x45, 0x3a, 0xe1, 0x2b, 0x53, 0x73, 0x9f,
-	0xfc, 0x77, 0x72, 0xbf, 0x14, 0xca, 0x3d, 0xd2, 0x60, 0xc5, 0xa3, 0xdf, 0xc1, 0x83, 0x1a, 0x4f,
-	0x37, 0x04, 0x22, 0x70, 0x1b, 0x0f, 0xae, 0x63, 0xfa, 0x61, 0x3c, 0x39, 0x9f, 0x8e, 0x55, 0x93,
-	0x6c, 0x50, 0x6b, 0xd9, 0x0d, 0x32, 0xd0, 0xce, 0xcb, 0x57, 0x6e, 0x58, 0x05, 0xa3, 0x27, 0xa8,
-	0x81, 0x19, 0xf6, 0xcb, 0x9d, 0x7f, 0x6b, 0x3f, 0xc4, 0xe0, 0xd4, 0xc8, 0xb8, 0xc4, 0xbd, 0x06,
-	0xaa, 0x86, 0x0d, 0xfc, 0x87, 0x60, 0x76, 0xd5, 0xb0, 0x01, 0x7d, 0x03, 0x55, 0xf3, 0x5f, 0xc2,
-	0x67, 0x27, 0x5c, 0xbb, 0x04, 0xa7, 0x27, 0x72, 0x76, 0x48, 0x8e, 0x4f, 0x0e, 0xfb, 0x35, 0x25,
-	0xd7, 0x88, 0xdf, 0x17, 0xb4, 0xbb, 0xc3, 0x5e, 0x2c, 0xa7, 0xa5, 0x8b, 0x0a, 0x24, 0xf9, 0xe3,
-	0x5d, 0xbb, 0x47, 0x64, 0x3f, 0x2c, 0x9d, 0xff, 0xf5, 0x65, 0x6e, 0xfb, 0x08, 0x67, 0xbe, 0x61,
-	0x33, 0xde, 0xb8, 0x85, 0xbb, 0xe2, 0x4f, 0xcb, 0xb0, 0x20, 0xfc, 0xa3, 0xcf, 0x15, 0x48, 0x48,
-	0xbd, 0x82, 0xb6, 0x26, 0x6b, 0x61, 0x8a, 0x20, 0x55, 0xb7, 0x67, 0x99, 0x79, 0x5c, 0xb5, 0xfc,
-	0xe3, 0x9f, 0xff, 0xf8, 0x76, 0xfe, 0x2c, 0xca, 0x71, 0xf9, 0xec, 0x50, 0x5f, 0x44, 0x4b, 0xbd,
-	0x62, 0x3c, 0x94, 0xb9, 0x7b, 0x84, 0xbe, 0x53, 0x60, 0x25, 0x24, 0x09, 0xd1, 0x85, 0x08, 0x88,
-	0x69, 0xd2, 0x53, 0xbd, 0x78, 0x34, 0x63, 0xc9, 0x4a, 0x17, 0xac, 0x0a, 0x68, 0x3b, 0xcc, 0xca,
-	0x57, 0x9e, 0x13, 0xe4, 0xbe, 0x57, 0x20, 0x3d, 0xae, 0xec, 0x90, 0x1e, 0x01, 0x19, 0x21, 0x28,
-	0x55, 0xe3, 0xc8, 0xf6, 0x92, 0xe5, 0x15, 0xc1, 0xf2, 0x32, 0xd2, 0xc3, 0x2c, 0xfb, 0xbe, 0xfd,
-	0x88, 0x68, 0x50, 0xa8, 0x3e, 0x42, 0x8f, 0x15, 0x48, 0x48, 0xfd, 0x16, 0x99, 0xce, 0xb0, 0x34,
-	0x8c, 0x4c, 0xe7, 0x98, 0x0c, 0xd4, 0x0a, 0x82, 0x92, 0x86, 0x36, 0xc3, 0x94, 0xa4, 0x16, 0xa4,
-	0x81, 0x90, 0x7d, 0xa9, 0x40, 0x42, 0xaa, 0xb8, 0x48, 0x12, 0x61, 0xc9, 0x18, 0x49, 0x62, 0x4c,
-	0x0c, 0x6a, 0x97, 0x04, 0x89, 0x3c, 0xda, 0x0a, 0x93, 0xa0, 0x9e, 0xd9, 0x88, 0x83, 0xf1, 0x70,
-	0x8f, 0xec, 0x3f, 0x42, 0x7d, 0x88, 0x73, 0xa1, 0x87, 0xb4, 0xc8, 0x12, 0x19, 0xaa, 0x47, 0xf5,
-	0xff, 0x87, 0xda, 0x48, 0xfc, 0x2d, 0x81, 0x9f, 0x43, 0x1b, 0xe3, 0xd5, 0xd3, 0x08, 0x45, 0x80,
-	0xc2, 0xa2, 0xa7, 0x73, 0xd0, 0x5b, 0x11, 0x5e, 0x43, 0x72, 0x4a, 0xdd, 0x9a, 0x61, 0x25, 0xd1,
-	0xd7, 0x05, 0xfa, 0x29, 0xb4, 0x1a, 0x46, 0xf7, 0x44, 0x14, 0x62, 0x90, 0x90, 0x1a, 0x0a, 0x6d,
-	0x4e, 0xfa, 0x0b, 0xcb, 0x2b, 0x35, 0x3f, 0xab, 0x67, 0xf8, 0x98, 0x59, 0x81, 0x99, 0x41, 0xa7,
-	0xc2, 0x98, 0x84, 0x35, 0x6b, 0x26, 0x87, 0x7a, 0x00, 0xa9, 0x80, 0x00, 0x3a, 0x02, 0xf2, 0x94,
-	0xb3, 0x4e, 0x51, 0x50, 0x9a, 0x26, 0x70, 0xd7, 0x91, 0x3a, 0x86, 0x2b, 0x4d, 0xf9, 0xf3, 0x8b,
-	0x06, 0x90, 0x90, 0x7d, 0x34, 0xb2, 0xce, 0xc2, 0x6a, 0x2b, 0xb2, 0xce, 0xc6, 0xda, 0x71, 0xd4,
-	0xa9, 0xbd, 0x06, 0xca, 0x06, 0xe8, 0x89, 0x02, 0x30, 0x7a, 0xe1, 0x51, 0xe1, 0x30, 0xb7, 0xc1,
-	0xc6, 0xad, 0x9e, 0x3b, 0x82, 0xa5, 0xe4, 0x70, 0x56, 0x70, 0x38, 0x83, 0xd6, 0xa6, 0x71, 0x10,
-	0x2d, 0x87, 0x07, 0x40, 0x76, 0x88, 0x43, 0x6e, 0x7b, 0xb0, 0xb1, 0x1c, 0x72, 0xdb, 0x43, 0x8d,
-	0x26, 0x2a, 0x00, 0x7e, 0xf3, 0x29, 0x55, 0x9e, 0xbf, 0xca, 0x2a, 0x2f, 0x5e, 0x65, 0x95, 0xdf,
-	0x5f, 0x65, 0x95, 0xa7, 0x07, 0xd9, 0xb9, 0x17, 0x07, 0xd9, 0xb9, 0x5f, 0x0e, 0xb2, 0x73, 0x9f,
-	0x5d, 0x08, 0x34, 0xa3, 0x0a, 0x35, 0xb1, 0x5d, 0xaa, 0x18, 0xa4, 0x2f, 0xff, 0x48, 0xe9, 0xef,
-	0x14, 0x8d, 0x81, 0x70, 0x26, 0xba, 0x52, 0x7d, 0x51, 0x34, 0xfd, 0xb7, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0x89, 0x58, 0xe0, 0x09, 0xe4, 0x11, 0x00, 0x00,
+	// 1641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x2d, 0xd9, 0x92, 0x47, 0xb6, 0xa3, 0xac, 0x95, 0x44, 0x66, 0x6c, 0x4b, 0xe6, 0x8b,
+	0x25, 0xc7, 0x2f, 0x26, 0x63, 0x3d, 0x20, 0xc0, 0x7b, 0x97, 0x57, 0xdb, 0x70, 0xd2, 0x34, 0x49,
+	0x9b, 0xaa, 0x46, 0x0f, 0x05, 0x02, 0x61, 0x45, 0xad, 0x29, 0xc1, 0x12, 0x57, 0xe1, 0x52, 0xaa,
+	0x9c, 0x34, 0x05, 0x1a, 0x04, 0xfd, 0x40, 0x2f, 0x01, 0x7a, 0xeb, 0x29, 0xc7, 0x02, 0xbd, 0xf5,
+	0x9f, 0x68, 0x8e, 0x01, 0x8a, 0x02, 0x45, 0x0f, 0x69, 0x91, 0xf4, 0x50, 0xf4, 0x4f, 0xe8, 0xa9,
+	0xd8, 0xe5, 0xd2, 0x22, 0xf5, 0x61, 0xd9, 0x45, 0x7a, 0x29, 0x7a, 0xe2, 0xee, 0xec, 0x7c, 0xfc,
+	0x76, 0x66, 0x38, 0x33, 0x0b, 0x0b, 0xc4, 0xad, 0x12, 0xa7, 0x51, 0xb3, 0x5d, 0x83, 0xb4, 0x1b,
+	0x46, 0x7b, 0xc3, 0xb8, 0xdb, 0x22, 0xce, 0x81, 0xde, 0x74, 0xa8, 0x4b, 0x51, 0xf2, 0xf0, 0x54,
+	0x27, 0xed, 0x86, 0xde, 0xde, 0x50, 0xd7, 0x4c, 0xca, 0x1a, 0x94, 0x19, 0x65, 0xcc, 0x88, 0xc7,
+	0x6a, 0xb4, 0x37, 0xca, 0xc4, 0xc5, 0x1b, 0x46, 0x13, 0x5b, 0x35, 0x1b, 0xbb, 0x35, 0x6a, 0x7b,
+	0xd2, 0xaa, 0xda, 0xa7, 0x9b, 0x2b, 0xf1, 0xce, 0xe6, 0xfb, 0xce, 0xdc, 0x8e, 0x3c, 0x4a, 0x59,
+	0xd4, 0xa2, 0x62, 0x69, 0xf0, 0x95, 0xa4, 0x2e, 0x58, 0x94, 0x5a, 0x75, 0x62, 0xe0, 0x66, 0xcd,
+	0xc0, 0xb6, 0x4d, 0x5d, 0x61, 0x89, 0xc9, 0xd3, 0x8c, 0x3c, 0x15, 0xbb, 0x72, 0x6b, 0xcf, 0x70,
+	0x6b, 0x0d, 0xc2, 0x5c, 0xdc, 0x68, 0x7a, 0x0c, 0xda, 0x7f, 0x61, 0xee, 0x6d, 0x8e, 0x76, 0xd3,
+	0x34, 0x69, 0xcb, 0x76, 0x8b, 0xe4, 0x6e, 0x8b, 0x30, 0x17, 0xa5, 0x21, 0x86, 0x2b, 0x15, 0x87,
+	0x30, 0x96, 0x56, 0xb2, 0xca, 0xea, 0x54, 0xd1, 0xdf, 0xfe, 0x2f, 0xfe, 0xe9, 0x93, 0xcc, 0xd8,
+	0xaf, 0x4f, 0x32, 0x63, 0x9a, 0x09, 0xa9, 0xb0, 0x28, 0x6b, 0x52, 0x9b, 0x11, 0x2e, 0x5b, 0xc6,
+	0x75, 0x6c, 0x9b, 0xc4, 0x97, 0x95, 0x5b, 0x74, 0x1e, 0xa6, 0x4c, 0x5a, 0x21, 0xa5, 0x2a, 0x66,
+	0xd5, 0xf4, 0xb8, 0x38, 0x8b, 0x73, 0xc2, 0xeb, 0x98, 0x55, 0x51, 0x0a, 0x26, 0x6c, 0xca, 0x85,
+	0x22, 0x59, 0x65, 0x35, 0x5a, 0xf4, 0x36, 0xda, 0xff, 0x61, 0x5e, 0x18, 0xd9, 0x16, 0xee, 0xfd,
+	0x13, 0x28, 0x3f, 0x56, 0x40, 0x1d, 0xa4, 0x41, 0x82, 0x5d, 0x81, 0x59, 0x2f, 0x72, 0xa5, 0xb0,
+	0xa6, 0x19, 0x8f, 0xba, 0xe9, 0x11, 0x91, 0x0a, 0x71, 0xc6, 0x8d, 0x72, 0x7c, 0xe3, 0x02, 0xdf,
+	0xe1, 0x9e, 0xab, 0xc0, 0x9e, 0xd6, 0x92, 0xdd, 0x6a, 0x94, 0x89, 0x23, 0x6f, 0x30, 0x23, 0xa9,
+	0x6f, 0x0a, 0xa2, 0x76, 0x03, 0x16, 0x04, 0x8e, 0x77, 0x71, 0xbd, 0x56, 0xc1, 0x2e, 0x75, 0x7a,
+	0x2e, 0xb3, 0x0c, 0xd3, 0x26, 0xb5, 0x7b, 0x71, 0x24, 0x38, 0x6d, 0xb3, 0xef, 0x56, 0x9f, 0x2b,
+	0xb0, 0x38, 0x44, 0x9b, 0xbc, 0x58, 0x1e, 0x4e, 0xf9, 0xa8, 0xc2, 0x1a, 0x7d, 0xb0, 0xaf, 0xf0,
+	0x6a, 0x7e, 0x12, 0x6d, 0x79, 0x71, 0x3e, 0x49, 0x78, 0x2e, 0x43, 0x2a, 0x2c, 0x3a, 0x2a, 0x89,
+	0xb4, 0x1b, 0xd2, 0xd8, 0x3b, 0x2e, 0x75, 0xb0, 0x35, 0xda, 0x18, 0x4a, 0x42, 0x64, 0x9f, 0x1c,
+	0xc8, 0x7c, 0xe3, 0xcb, 0x80, 0xf9, 0x4b, 0x90, 0x0a, 0x2b, 0x93, 0xe6, 0x53, 0x30, 0xd1, 0xc6,
+	0xf5, 0x96, 0x6f, 0xdc, 0xdb, 0x68, 0x57, 0x20, 0x29, 0x53, 0xa9, 0x72, 0xa2, 0x4b, 0xe6, 0xe1,
+	0x74, 0x40, 0x4e, 0x9a, 0x40, 0x10, 0xe5, 0xb9, 0x2f, 0xa4, 0xa6, 0x8b, 0x62, 0xad, 0xdd, 0x03,
+	0x24, 0x18, 0x77, 0x3b, 0x37, 0xa9, 0xc5, 0x7c, 0x13, 0x08, 0xa2, 0xe2, 0x8f, 0xf1, 0xf4, 0x8b,
+	0x35, 0xba, 0x0a, 0xd0, 0xad, 0x2b, 0xe2, 0x6e, 0x89, 0x42, 0x4e, 0xf7, 0x92, 0x56, 0xe7, 0x45,
+	0x48, 0xf7, 0xea, 0x95, 0x2c, 0x42, 0xfa, 0xed, 0xae, 0xab, 0x8a, 0x01, 0xc9, 0x00, 0xc8, 0xcf,
+	0x14, 0x98, 0x0b, 0x19, 0x97, 0x38, 0x2f, 0x42, 0xb4, 0x4e, 0x2d, 0x7e, 0xbb, 0xc8, 0x6a, 0xa2,
+	0x70, 0x46, 0xef, 0x2d, 0x7d, 0xfa, 0x4d, 0x6a, 0x15, 0x05, 0x0b, 0xba, 0x36, 0x00, 0x54, 0x7e,
+	0x24, 0x28, 0xcf, 0x4e, 0x10, 0x95, 0x96, 0x92, 0x7e, 0xb8, 0x8d, 0x1d, 0xdc, 0xf0, 0xfd, 0xa0,
+	0xdd, 0x82, 0xb9, 0x10, 0x55, 0x02, 0xbc, 0x02, 0x93, 0x4d, 0x41, 0x11, 0x0e, 0x4a, 0x14, 0xd2,
+	0xfd, 0x10, 0x3d, 0x89, 0xad, 0xe8, 0xd3, 0xe7, 0x99, 0xb1, 0xa2, 0xe4, 0xd6, 0x96, 0x21, 0x23,
+	0xa3, 0x62, 0xbb, 0x0e, 0x36, 0xdd, 0xdb, 0x5c, 0x86, 0x31, 0x5e, 0x3d, 0x7d, 0x8b, 0x1f, 0x40,
+	0x76, 0x38, 0x8b, 0x34, 0xbf, 0x0e, 0xa8, 0x42, 0x9a, 0x75, 0x7a, 0x40, 0x9c, 0x12, 0xae, 0xd7,
+	0xe9, 0xfb, 0xf5, 0x1a, 0x73, 0x85, 0xb7, 0xa6, 0x8a, 0xa7, 0xfd, 0x93, 0x4d, 0xff, 0x00, 0x5d,
+	0x84, 0xe4, 0x9e, 0x43, 0xef, 0x11, 0xbb, 0x64, 0x4a, 0xa5, 0x2c, 0x3d, 0x2e, 0x98, 0x4f, 0x79,
+	0x74, 0xdf, 0x16, 0xd3, 0xbe, 0x57, 0x60, 0x76, 0xc7, 0xad, 0x6e, 0xe3, 0x7a, 0x3d, 0x90, 0x0a,
+	0xd8, 0xb1, 0x98, 0x9f, 0x34, 0x7c, 0x8d, 0xce, 0x41, 0xcc, 0xc2, 0xac, 0x64, 0xe2, 0xa6, 0xfc,
+	0x7f, 0x27, 0x2d, 0xcc, 0xb6, 0x71, 0x13, 0xdd, 0x81, 0x64, 0xd3, 0xa1, 0x4d, 0xca, 0x38, 0x32,
+	0x99, 0xa3, 0xfc, 0xff, 0x9d, 0xde, 0x2a, 0xfc, 0xfe, 0x3c, 0xa3, 0x5b, 0x35, 0xb7, 0xda, 0x2a,
+	0xeb, 0x26, 0x6d, 0x18, 0xb2, 0x79, 0x79, 0x9f, 0x75, 0x56, 0xd9, 0x37, 0xdc, 0x83, 0x26, 0x61,
+	0xfa, 0x76, 0xb7, 0xf8, 0x14, 0x4f, 0xf9, 0xba, 0x24, 0x01, 0xcd, 0x43, 0xdc, 0xac, 0xe2, 0x9a,
+	0x5d, 0xaa, 0x55, 0xd2, 0xd1, 0xac, 0xb2, 0x1a, 0x29, 0xc6, 0xc4, 0xfe, 0x7a, 0x05, 0x2d, 0xc0,
+	0x14, 0x6d, 0x13, 0xc7, 0xa9, 0x55, 0x08, 0x4b, 0x4f, 0x08, 0xac, 0x5d, 0x82, 0x96, 0x87, 0xb9,
+	0x1d, 0xe6, 0xd6, 0x1a, 0xd8, 0x25, 0xd7, 0x70, 0xd7, 0x91, 0x49, 0x88, 0x58, 0xd8, 0xbb, 0x5a,
+	0xb4, 0xc8, 0x97, 0xda, 0xa3, 0xa8, 0x9f, 0x92, 0x0e, 0x36, 0xc9, 0x6e, 0xc7, 0xf7, 0xc2, 0x06,
+	0x44, 0x1a, 0xcc, 0x92, 0xe1, 0xce, 0xf4, 0x87, 0xfb, 0x16, 0xb3, 0x76, 0x38, 0x8d, 0xb4, 0x1a,
+	0xbb, 0x9d, 0x22, 0xe7, 0x45, 0xaf, 0xc1, 0x34, 0xf7, 0x2a, 0xe1, 0x5e, 0xdf, 0xab, 0x59, 0xc2,
+	0x0f, 0x89, 0xc2, 0x62, 0xbf, 0xac, 0x30, 0xb5, 0x2d, 0x98, 0x8a, 0x09, 0xb7, 0xbb, 0x41, 0xdb,
+	0x30, 0xdd, 0x74, 0x48, 0x85, 0x98, 0x84, 0x31, 0xea, 0xb0, 0x74, 0x34, 0x1b, 0x39, 0x8e, 0xf5,
+	0x90, 0x10, 0x2f, 0xf2, 0xe5, 0x3a, 0x35, 0xf7, 0xfd, 0x72, 0x3a, 0x21, 0xfc, 0x96, 0x10, 0x34,
+	0xaf, 0x98, 0xa2, 0x45, 0x00, 0x8f, 0x45, 0xfc, 0xf3, 0x93, 0xe2, 0x9f, 0x9f, 0x12, 0x14, 0xd1,
+	0x26, 0xb7, 0xfd, 0x63, 0xde, 0xc9, 0xd3, 0x31, 0x71, 0x0d, 0x55, 0xf7, 0xda, 0xbc, 0xee, 0xb7,
+	0x79, 0x7d, 0xd7, 0x6f, 0xf3, 0x5b, 0x71, 0x9e, 0xf3, 0x8f, 0x7f, 0xca, 0x28, 0x52, 0x09, 0x3f,
+	0x19, 0x98, 0x19, 0xf1, 0xbf, 0x26, 0x33, 0xa6, 0xc2, 0x99, 0xa1, 0xc1, 0x8c, 0x07, 0xbf, 0x81,
+	0x3b, 0x25, 0x1e, 0x6e, 0x08, 0x78, 0xe0, 0x16, 0xee, 0x5c, 0xc3, 0xec, 0x8d, 0x68, 0x7c, 0x3c,
+	0x19, 0x29, 0xc6, 0xdd, 0x4e, 0xa9, 0x66, 0x57, 0x48, 0x47, 0x5b, 0x93, 0x45, 0xfa, 0x30, 0x0b,
+	0xba, 0x15, 0xb4, 0x82, 0x5d, 0xec, 0xff, 0x0c, 0x7c, 0xad, 0x7d, 0x13, 0x81, 0xb3, 0x5d, 0xe6,
+	0x2d, 0xae, 0x35, 0x90, 0x35, 0x6e, 0xc7, 0xaf, 0x63, 0xa3, 0xb3, 0xc6, 0xed, 0xb0, 0x57, 0x90,
+	0x35, 0xff, 0x04, 0x7c, 0x74, 0xc0, 0xb5, 0x75, 0x38, 0xd7, 0x17, 0xb3, 0x23, 0x62, 0xfc, 0xed,
+	0x38, 0x9c, 0xe9, 0xf2, 0xff, 0xdd, 0xca, 0x23, 0x9f, 0xdc, 0x3c, 0x8f, 0x75, 0x79, 0x26, 0x05,
+	0xcf, 0xac, 0x20, 0xbf, 0x75, 0xc8, 0xd8, 0x9b, 0x9d, 0xb1, 0x93, 0x66, 0xa7, 0x76, 0xe6, 0x70,
+	0x70, 0x63, 0xe4, 0x2a, 0xf1, 0x07, 0x04, 0xed, 0x0e, 0xa4, 0xc2, 0x64, 0x19, 0x8c, 0x1d, 0x88,
+	0xf3, 0x2e, 0x5e, 0xda, 0x23, 0x72, 0x30, 0xda, 0x5a, 0xfb, 0xf1, 0x79, 0x26, 0x77, 0x0c, 0x4f,
+	0x5d, 0xb7, 0x5d, 0x3e, 0xc1, 0x09, 0x75, 0x85, 0xdf, 0x66, 0x61, 0x42, 0xe8, 0x47, 0x1f, 0x29,
+	0x10, 0x93, 0x83, 0x2b, 0x5a, 0xe9, 0xc7, 0x3d, 0xe0, 0x65, 0xa2, 0xe6, 0x46, 0xb1, 0x79, 0x58,
+	0xb5, 0xfc, 0xc3, 0xef, 0x7e, 0xf9, 0x62, 0x7c, 0x19, 0x65, 0xf8, 0x3b, 0x8a, 0x32, 0xff, 0x35,
+	0x25, 0x07, 0x57, 0xe3, 0xbe, 0x8c, 0xf8, 0x03, 0xf4, 0xa5, 0x02, 0x33, 0xa1, 0xb7, 0x01, 0xfa,
+	0xf7, 0x10, 0x13, 0x83, 0xde, 0x20, 0xea, 0xa5, 0xe3, 0x31, 0x4b, 0x54, 0xba, 0x40, 0xb5, 0x8a,
+	0x72, 0x61, 0x54, 0xfe, 0x13, 0xa4, 0x0f, 0xdc, 0xd7, 0x0a, 0x24, 0x7b, 0x47, 0x7c, 0xa4, 0x0f,
+	0x31, 0x39, 0xe4, 0x65, 0xa1, 0x1a, 0xc7, 0xe6, 0x97, 0x28, 0xaf, 0x08, 0x94, 0x97, 0x91, 0x1e,
+	0x46, 0xd9, 0xf6, 0xf9, 0xbb, 0x40, 0x83, 0x2f, 0x96, 0x07, 0xe8, 0xa1, 0x02, 0x31, 0x39, 0xc8,
+	0x0f, 0x0d, 0x67, 0xf8, 0x8d, 0xa0, 0xe6, 0x46, 0xb1, 0x49, 0x48, 0xab, 0x02, 0x92, 0x86, 0xb2,
+	0x61, 0x48, 0xf2, 0x51, 0xc0, 0x02, 0x2e, 0xfb, 0x44, 0x81, 0x98, 0x1c, 0xe7, 0x87, 0x82, 0x08,
+	0xbf, 0x1d, 0xd4, 0xdc, 0x28, 0x36, 0x09, 0x62, 0x5d, 0x80, 0xc8, 0xa3, 0x95, 0x30, 0x08, 0xe6,
+	0xb1, 0x75, 0x31, 0x18, 0xf7, 0xf7, 0xc9, 0xc1, 0x03, 0xd4, 0x86, 0x28, 0x9f, 0xf8, 0x91, 0x36,
+	0x34, 0x45, 0x0e, 0x9f, 0x11, 0xea, 0xbf, 0x8e, 0xe4, 0x91, 0xf6, 0x57, 0x84, 0xfd, 0x0c, 0x5a,
+	0xec, 0xcd, 0x9e, 0x4a, 0xc8, 0x03, 0x0c, 0x26, 0xbd, 0x81, 0x17, 0x5d, 0x18, 0xa2, 0x35, 0x34,
+	0x57, 0xab, 0x2b, 0x23, 0xb8, 0xa4, 0xf5, 0x05, 0x61, 0xfd, 0x2c, 0x4a, 0x85, 0xad, 0x7b, 0xd3,
+	0x34, 0xfa, 0x4a, 0x81, 0xb9, 0x01, 0x63, 0x32, 0xda, 0x18, 0x7a, 0xb1, 0x61, 0x53, 0xb7, 0x5a,
+	0x38, 0x89, 0x88, 0x04, 0xb7, 0x26, 0xc0, 0x5d, 0x40, 0x5a, 0xaf, 0x6b, 0x3c, 0x91, 0x52, 0x33,
+	0x00, 0xc9, 0x85, 0x98, 0x1c, 0xab, 0x51, 0xb6, 0xdf, 0x54, 0x78, 0xe2, 0x56, 0xf3, 0xa3, 0x06,
+	0x05, 0x1f, 0xc1, 0x92, 0x40, 0x90, 0x46, 0x67, 0xc3, 0x08, 0x88, 0x5b, 0x2d, 0x99, 0xdc, 0xd4,
+	0x3d, 0x48, 0x04, 0xa6, 0xde, 0x63, 0x58, 0x1e, 0x10, 0x96, 0x01, 0x63, 0xb3, 0xa6, 0x09, 0xbb,
+	0x0b, 0x48, 0xed, 0xb1, 0x2b, 0x59, 0x79, 0xcf, 0x45, 0x1d, 0x88, 0xc9, 0xe1, 0x69, 0xe8, 0x2f,
+	0x11, 0x1e, 0xb1, 0xd5, 0xdc, 0x28, 0xb6, 0xa3, 0x6f, 0xed, 0xf5, 0x25, 0xb7, 0x83, 0x1e, 0x29,
+	0x00, 0xdd, 0xb6, 0x8e, 0x56, 0x8f, 0x52, 0x1b, 0x9c, 0xd6, 0xd4, 0x8b, 0xc7, 0xe0, 0x94, 0x18,
+	0x96, 0x05, 0x86, 0xf3, 0x68, 0x7e, 0x10, 0x06, 0xd1, 0x33, 0xd1, 0x87, 0x30, 0x75, 0x38, 0x2c,
+	0xa0, 0xfc, 0x51, 0xaa, 0x83, 0x11, 0x38, 0xae, 0x13, 0xb2, 0x02, 0x80, 0x8a, 0xd2, 0x83, 0x00,
+	0x88, 0xe0, 0x77, 0x78, 0x61, 0x14, 0xdd, 0xef, 0x88, 0xc2, 0x18, 0xec, 0xc1, 0x6a, 0x6e, 0x14,
+	0xdb, 0xd1, 0x01, 0xf0, 0xfb, 0xf4, 0xd6, 0xf5, 0xa7, 0x2f, 0x96, 0x94, 0x67, 0x2f, 0x96, 0x94,
+	0x9f, 0x5f, 0x2c, 0x29, 0x8f, 0x5f, 0x2e, 0x8d, 0x3d, 0x7b, 0xb9, 0x34, 0xf6, 0xc3, 0xcb, 0xa5,
+	0xb1, 0xf7, 0x8c, 0x40, 0xdf, 0x66, 0xc4, 0x69, 0x8b, 0x59, 0xd2, 0xa4, 0x75, 0xea, 0x58, 0x62,
+	0x6f, 0xb4, 0x37, 0x0a, 0x46, 0x47, 0x28, 0x14, 0x4d, 0xbc, 0x3c, 0x29, 0x38, 0xfe, 0xf3, 0xc7,
+	0x00, 0x7e, 0x4c, 0x55, 0x0b, 0x1c, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1455,6 +1677,8 @@ type QueryClient interface {
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ContractPermissions queries the deployer allowlist and the frozen contracts of x/evm module.
+	ContractPermissions(ctx context.Context, in *QueryContractPermissionsRequest, opts ...grpc.CallOption) (*QueryContractPermissionsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
@@ -1463,6 +1687,8 @@ type QueryClient interface {
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1539,6 +1765,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ContractPermissions(ctx context.Context, in *QueryContractPermissionsRequest, opts ...grpc.CallOption) (*QueryContractPermissionsResponse, error) {
+	out := new(QueryContractPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ContractPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
 	out := new(MsgEthereumTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EthCall", in, out, opts...)
@@ -1575,6 +1810,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
+	out := new(QueryTraceTxResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1602,6 +1846,8 @@ type QueryServer interface {
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ContractPermissions queries the deployer allowlist and the frozen contracts of x/evm module.
+	ContractPermissions(context.Context, *QueryContractPermissionsRequest) (*QueryContractPermissionsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
@@ -1610,6 +1856,8 @@ type QueryServer interface {
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceTxResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1640,6 +1888,9 @@ func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ContractPermissions(ctx context.Context, req *QueryContractPermissionsRequest) (*QueryContractPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractPermissions not implemented")
+}
 func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallRequest) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
 }
@@ -1652,6 +1903,9 @@ func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxR
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1786,6 +2040,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/ContractPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractPermissions(ctx, req.(*QueryContractPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
@@ -1858,6 +2130,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -1908,6 +2198,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ContractPermissions",
+			Handler:    _Query_ContractPermissions_Handler,
+		},
 		{
 			MethodName: "EthCall",
 			Handler:    _Query_EthCall_Handler,
@@ -1924,6 +2218,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2479,6 +2777,70 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryContractPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenContracts[iNdEx])
+			copy(dAtA[i:], m.FrozenContracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FrozenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeployerAllowlist) > 0 {
+		for iNdEx := len(m.DeployerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeployerAllowlist[iNdEx])
+			copy(dAtA[i:], m.DeployerAllowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DeployerAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2499,6 +2861,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2798,7 +3167,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2808,32 +3177,105 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -3102,6 +3544,36 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContractPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryContractPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeployerAllowlist) > 0 {
+		for _, s := range m.DeployerAllowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FrozenContracts) > 0 {
+		for _, s := range m.FrozenContracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EthCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3122,6 +3594,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3243,6 +3719,41 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBaseFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4787,7 +5298,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractPermissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4810,17 +5321,67 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractPermissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAllowlist", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4830,50 +5391,29 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
-			}
+			m.DeployerAllowlist = append(m.DeployerAllowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
-			}
-			m.GasCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4883,45 +5423,24 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
-			}
+			m.FrozenContracts = append(m.FrozenContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4943,7 +5462,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4966,7 +5485,197 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -5768,6 +6477,266 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,16 +1,18 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"os"
 	"time"
 
-	"github.com/ethereum/go-ethereum/eth/tracers/logger"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/tracers/logger"
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 const (
@@ -20,6 +22,19 @@ const (
 	TracerMarkdown   = "markdown"
 )
 
+// Native tracers selectable through TraceConfig.Tracer, configured by TraceConfig.TracerJsonConfig.
+const (
+	// TracerCall returns the call frames of a transaction, its configuration fields are
+	// onlyTopCall and withLog.
+	TracerCall = "callTracer"
+	// TracerPrestate returns the accounts touched by a transaction, its configuration field
+	// is diffMode.
+	TracerPrestate = "prestateTracer"
+	// TracerFlatCall returns the call frames of a transaction as a flat list of Parity style
+	// traces, its configuration fields are convertParityErrors and includePrecompiles.
+	TracerFlatCall = "flatCallTracer"
+)
+
 // NewTracer creates a new Logger tracer to collect execution traces from an
 // EVM transaction.
 func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height int64) vm.EVMLogger {
@@ -43,6 +58,31 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// UnmarshalJSON implements json.Unmarshaler. The tracer configuration can be given as a JSON
+// object, as with go-ethereum, or as a JSON encoded string.
+func (tc *TraceConfig) UnmarshalJSON(bz []byte) error {
+	type traceConfig TraceConfig
+	aux := struct {
+		*traceConfig
+		TracerConfig json.RawMessage `json:"tracerConfig"`
+	}{traceConfig: (*traceConfig)(tc)}
+	if err := json.Unmarshal(bz, &aux); err != nil {
+		return err
+	}
+
+	switch {
+	case len(aux.TracerConfig) == 0 || string(aux.TracerConfig) == "null":
+		tc.TracerJsonConfig = ""
+	case aux.TracerConfig[0] == '"':
+		return json.Unmarshal(aux.TracerConfig, &tc.TracerJsonConfig)
+	case aux.TracerConfig[0] == '{':
+		tc.TracerJsonConfig = string(aux.TracerConfig)
+	default:
+		return fmt.Errorf("invalid tracer config %s, expected a JSON object", aux.TracerConfig)
+	}
+	return nil
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,3 +10,50 @@ import (
 func TestNewNoOpTracer(t *testing.T) {
 	require.Equal(t, &NoOpTracer{}, NewNoOpTracer())
 }
+
+func TestTraceConfigUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expConfig TraceConfig
+		expPass   bool
+	}{
+		{
+			"tracer config as object",
+			`{"tracer":"callTracer","tracerConfig":{"onlyTopCall":true},"timeout":"5s"}`,
+			TraceConfig{Tracer: TracerCall, TracerJsonConfig: `{"onlyTopCall":true}`, Timeout: "5s"},
+			true,
+		},
+		{
+			"tracer config as string",
+			`{"tracer":"prestateTracer","tracerConfig":"{\"diffMode\":true}"}`,
+			TraceConfig{Tracer: TracerPrestate, TracerJsonConfig: `{"diffMode":true}`},
+			true,
+		},
+		{
+			"without tracer config",
+			`{"disableStack":true,"tracerConfig":null}`,
+			TraceConfig{DisableStack: true},
+			true,
+		},
+		{
+			"invalid tracer config",
+			`{"tracerConfig":5}`,
+			TraceConfig{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var config TraceConfig
+			err := json.Unmarshal([]byte(tc.input), &config)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expConfig, config)
+		})
+	}
+}
@@ -7,7 +7,8 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/servprotocolorg/serv/v12/x/evm/core/vm"
 )
 
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
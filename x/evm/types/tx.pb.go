@@ -440,6 +440,290 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateDeployerAllowlist defines a Msg for replacing the list of the addresses allowed to
+// deploy contracts.
+type MsgUpdateDeployerAllowlist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// deployers defines the hex addresses of the accounts and factory contracts allowed to deploy
+	// contracts, an empty list allows every address to deploy contracts.
+	// NOTE: The list replaces the current allowlist.
+	Deployers []string `protobuf:"bytes,2,rep,name=deployers,proto3" json:"deployers,omitempty"`
+}
+
+func (m *MsgUpdateDeployerAllowlist) Reset()         { *m = MsgUpdateDeployerAllowlist{} }
+func (m *MsgUpdateDeployerAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeployerAllowlist) ProtoMessage()    {}
+func (*MsgUpdateDeployerAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgUpdateDeployerAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeployerAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeployerAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeployerAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeployerAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateDeployerAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeployerAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeployerAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeployerAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdateDeployerAllowlist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateDeployerAllowlist) GetDeployers() []string {
+	if m != nil {
+		return m.Deployers
+	}
+	return nil
+}
+
+// MsgUpdateDeployerAllowlistResponse defines the response structure for executing a
+// MsgUpdateDeployerAllowlist message.
+type MsgUpdateDeployerAllowlistResponse struct {
+}
+
+func (m *MsgUpdateDeployerAllowlistResponse) Reset()         { *m = MsgUpdateDeployerAllowlistResponse{} }
+func (m *MsgUpdateDeployerAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeployerAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateDeployerAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgUpdateDeployerAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeployerAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeployerAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeployerAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeployerAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateDeployerAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeployerAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeployerAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeployerAllowlistResponse proto.InternalMessageInfo
+
+// MsgUpdateFrozenContracts defines a Msg for replacing the list of the frozen contracts.
+type MsgUpdateFrozenContracts struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contracts defines the hex addresses of the contracts rejecting all calls.
+	// NOTE: The list replaces the current list of frozen contracts.
+	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (m *MsgUpdateFrozenContracts) Reset()         { *m = MsgUpdateFrozenContracts{} }
+func (m *MsgUpdateFrozenContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFrozenContracts) ProtoMessage()    {}
+func (*MsgUpdateFrozenContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgUpdateFrozenContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFrozenContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFrozenContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFrozenContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFrozenContracts.Merge(m, src)
+}
+func (m *MsgUpdateFrozenContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFrozenContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFrozenContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFrozenContracts proto.InternalMessageInfo
+
+func (m *MsgUpdateFrozenContracts) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateFrozenContracts) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+// MsgUpdateFrozenContractsResponse defines the response structure for executing a
+// MsgUpdateFrozenContracts message.
+type MsgUpdateFrozenContractsResponse struct {
+}
+
+func (m *MsgUpdateFrozenContractsResponse) Reset()         { *m = MsgUpdateFrozenContractsResponse{} }
+func (m *MsgUpdateFrozenContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFrozenContractsResponse) ProtoMessage()    {}
+func (*MsgUpdateFrozenContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgUpdateFrozenContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFrozenContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFrozenContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFrozenContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFrozenContractsResponse.Merge(m, src)
+}
+func (m *MsgUpdateFrozenContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFrozenContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFrozenContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFrozenContractsResponse proto.InternalMessageInfo
+
+// MsgRegisterPreinstalls defines a Msg for placing contracts at fixed addresses.
+type MsgRegisterPreinstalls struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// preinstalls defines the contracts to place at their addresses, the addresses must not
+	// have code.
+	Preinstalls []Preinstall `protobuf:"bytes,2,rep,name=preinstalls,proto3" json:"preinstalls"`
+}
+
+func (m *MsgRegisterPreinstalls) Reset()         { *m = MsgRegisterPreinstalls{} }
+func (m *MsgRegisterPreinstalls) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPreinstalls) ProtoMessage()    {}
+func (*MsgRegisterPreinstalls) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{12}
+}
+func (m *MsgRegisterPreinstalls) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPreinstalls) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPreinstalls.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPreinstalls) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPreinstalls.Merge(m, src)
+}
+func (m *MsgRegisterPreinstalls) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPreinstalls) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPreinstalls.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPreinstalls proto.InternalMessageInfo
+
+func (m *MsgRegisterPreinstalls) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterPreinstalls) GetPreinstalls() []Preinstall {
+	if m != nil {
+		return m.Preinstalls
+	}
+	return nil
+}
+
+// MsgRegisterPreinstallsResponse defines the response structure for executing a
+// MsgRegisterPreinstalls message.
+type MsgRegisterPreinstallsResponse struct {
+}
+
+func (m *MsgRegisterPreinstallsResponse) Reset()         { *m = MsgRegisterPreinstallsResponse{} }
+func (m *MsgRegisterPreinstallsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPreinstallsResponse) ProtoMessage()    {}
+func (*MsgRegisterPreinstallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{13}
+}
+func (m *MsgRegisterPreinstallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPreinstallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPreinstallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPreinstallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPreinstallsResponse.Merge(m, src)
+}
+func (m *MsgRegisterPreinstallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPreinstallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPreinstallsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPreinstallsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -449,74 +733,91 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateDeployerAllowlist)(nil), "ethermint.evm.v1.MsgUpdateDeployerAllowlist")
+	proto.RegisterType((*MsgUpdateDeployerAllowlistResponse)(nil), "ethermint.evm.v1.MsgUpdateDeployerAllowlistResponse")
+	proto.RegisterType((*MsgUpdateFrozenContracts)(nil), "ethermint.evm.v1.MsgUpdateFrozenContracts")
+	proto.RegisterType((*MsgUpdateFrozenContractsResponse)(nil), "ethermint.evm.v1.MsgUpdateFrozenContractsResponse")
+	proto.RegisterType((*MsgRegisterPreinstalls)(nil), "ethermint.evm.v1.MsgRegisterPreinstalls")
+	proto.RegisterType((*MsgRegisterPreinstallsResponse)(nil), "ethermint.evm.v1.MsgRegisterPreinstallsResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x24, 0x94, 0x6a, 0xb4, 0x55, 0x9d, 0x00, 0x71, 0x9a, 0x03, 0xa4,
-	0x45, 0xb1, 0xb5, 0x01, 0xf5, 0xb0, 0x27, 0x36, 0xdd, 0xb4, 0x6a, 0xb5, 0x2b, 0x2a, 0x93, 0x5e,
-	0x28, 0x52, 0x34, 0xeb, 0xcc, 0x4e, 0x2c, 0x62, 0x8f, 0xe5, 0x99, 0x58, 0x09, 0xc7, 0x9e, 0xb8,
-	0x01, 0xe2, 0x0b, 0x70, 0xe0, 0xc4, 0x09, 0x89, 0x7e, 0x00, 0x8e, 0x15, 0xa7, 0x0a, 0x2e, 0x88,
-	0x83, 0x41, 0x59, 0x24, 0xa4, 0xbd, 0xc1, 0x27, 0x40, 0x33, 0xe3, 0x6c, 0x36, 0x0d, 0xdb, 0x42,
-	0x29, 0xe2, 0xe4, 0x79, 0x7e, 0x6f, 0xde, 0x7b, 0xf3, 0xfb, 0xfd, 0xe6, 0x0f, 0xa8, 0x61, 0x3e,
-	0xc6, 0x91, 0xef, 0x05, 0xdc, 0xc6, 0xb1, 0x6f, 0xc7, 0xdb, 0x36, 0x9f, 0x59, 0x61, 0x44, 0x39,
-	0x85, 0x17, 0x4f, 0x5d, 0x16, 0x8e, 0x7d, 0x2b, 0xde, 0xae, 0x5f, 0x76, 0x29, 0xf3, 0x29, 0xb3,
-	0x7d, 0x46, 0x44, 0xa4, 0xcf, 0x88, 0x0a, 0xad, 0xd7, 0x94, 0x63, 0x28, 0x2d, 0x5b, 0x19, 0xa9,
-	0xab, 0xbe, 0x51, 0x40, 0x24, 0x53, 0xbe, 0x2d, 0x42, 0x09, 0x55, 0x73, 0xc4, 0x28, 0xfd, 0xfb,
-	0x2a, 0xa1, 0x94, 0x4c, 0xb0, 0x8d, 0x42, 0xcf, 0x46, 0x41, 0x40, 0x39, 0xe2, 0x1e, 0x0d, 0x96,
-	0xf9, 0x6a, 0xa9, 0x57, 0x5a, 0x87, 0xd3, 0x23, 0x1b, 0x05, 0x73, 0xe5, 0x6a, 0x7d, 0xa2, 0x81,
-	0x97, 0x0e, 0x18, 0xe9, 0x8b, 0x82, 0x78, 0xea, 0x0f, 0x66, 0xb0, 0x0d, 0xf4, 0x11, 0xe2, 0xc8,
-	0xd0, 0x9a, 0x5a, 0xbb, 0xd2, 0xdd, 0xb2, 0xd4, 0x5c, 0x6b, 0x39, 0xd7, 0xda, 0x0d, 0xe6, 0x8e,
-	0x8c, 0x80, 0x35, 0xa0, 0x33, 0xef, 0x23, 0x6c, 0x64, 0x9b, 0x5a, 0x5b, 0xeb, 0xe5, 0x4f, 0x12,
-	0x53, 0xeb, 0x38, 0xf2, 0x17, 0x34, 0x81, 0x3e, 0x46, 0x6c, 0x6c, 0xe4, 0x9a, 0x5a, 0xbb, 0xdc,
-	0xab, 0xfc, 0x91, 0x98, 0xc5, 0x68, 0x12, 0xee, 0xb4, 0x3a, 0x2d, 0x47, 0x3a, 0x20, 0x04, 0xfa,
-	0x51, 0x44, 0x7d, 0x43, 0x17, 0x01, 0x8e, 0x1c, 0xef, 0xe8, 0x1f, 0x7f, 0x61, 0x66, 0x5a, 0xdf,
-	0x64, 0x41, 0x69, 0x1f, 0x13, 0xe4, 0xce, 0x07, 0x33, 0xb8, 0x05, 0xf2, 0x01, 0x0d, 0x5c, 0x2c,
-	0xbb, 0xd1, 0x1d, 0x65, 0xc0, 0x5b, 0xa0, 0x4c, 0x90, 0x40, 0xce, 0x73, 0x55, 0xf5, 0x72, 0xef,
-	0xda, 0x4f, 0x89, 0xf9, 0x3a, 0xf1, 0xf8, 0x78, 0x7a, 0x68, 0xb9, 0xd4, 0x4f, 0xf1, 0x4c, 0x3f,
-	0x1d, 0x36, 0xfa, 0xd0, 0xe6, 0xf3, 0x10, 0x33, 0xeb, 0x76, 0xc0, 0x9d, 0x12, 0x41, 0xec, 0xae,
-	0x98, 0x0b, 0x1b, 0x20, 0x47, 0x10, 0x93, 0x5d, 0xea, 0xbd, 0xea, 0x22, 0x31, 0x4b, 0xb7, 0x10,
-	0xdb, 0xf7, 0x7c, 0x8f, 0x3b, 0xc2, 0x01, 0x2f, 0x80, 0x2c, 0xa7, 0x69, 0x8f, 0x59, 0x4e, 0xe1,
-	0x1d, 0x90, 0x8f, 0xd1, 0x64, 0x8a, 0x8d, 0xbc, 0x2c, 0xfa, 0xf6, 0xdf, 0x2f, 0xba, 0x48, 0xcc,
-	0xc2, 0xae, 0x4f, 0xa7, 0x01, 0x77, 0x54, 0x0a, 0x81, 0x80, 0xc4, 0xb9, 0xd0, 0xd4, 0xda, 0xd5,
-	0x14, 0xd1, 0x2a, 0xd0, 0x62, 0xa3, 0x28, 0x7f, 0x68, 0xb1, 0xb0, 0x22, 0xa3, 0xa4, 0xac, 0x48,
-	0x58, 0xcc, 0x28, 0x2b, 0x8b, 0xed, 0x5c, 0x10, 0x58, 0x7d, 0xf7, 0xb0, 0x53, 0x18, 0xcc, 0xf6,
-	0x10, 0x47, 0xad, 0xdf, 0x73, 0xa0, 0xba, 0xeb, 0xba, 0x98, 0xb1, 0x7d, 0x8f, 0xf1, 0xc1, 0x0c,
-	0xde, 0x07, 0x25, 0x77, 0x8c, 0xbc, 0x60, 0xe8, 0x8d, 0x24, 0x78, 0xe5, 0xde, 0x3b, 0xff, 0xa8,
-	0xdb, 0xe2, 0x0d, 0x31, 0xfb, 0xf6, 0xde, 0x49, 0x62, 0x16, 0x5d, 0x35, 0x74, 0xd2, 0xc1, 0x68,
-	0x45, 0x4b, 0xf6, 0x5c, 0x5a, 0x72, 0xff, 0x9e, 0x16, 0xfd, 0xe9, 0xb4, 0xe4, 0x37, 0x69, 0x29,
-	0xbc, 0x38, 0x5a, 0x8a, 0x67, 0x68, 0xb9, 0x0f, 0x4a, 0x48, 0x62, 0x8b, 0x99, 0x51, 0x6a, 0xe6,
-	0xda, 0x95, 0xee, 0x6b, 0xd6, 0x93, 0x1b, 0xdd, 0x52, 0xe8, 0x0f, 0xa6, 0xe1, 0x04, 0xf7, 0x9a,
-	0x8f, 0x12, 0x33, 0x73, 0x92, 0x98, 0x00, 0x9d, 0x52, 0xf2, 0xd5, 0xcf, 0x26, 0x58, 0x11, 0xe4,
-	0x9c, 0x26, 0x54, 0x9c, 0x97, 0xd7, 0x38, 0x07, 0x6b, 0x9c, 0x57, 0xce, 0xe3, 0xfc, 0x5b, 0x1d,
-	0x54, 0xf7, 0xe6, 0x01, 0xf2, 0x3d, 0xf7, 0x26, 0xc6, 0xff, 0x0f, 0xe7, 0x77, 0x40, 0x45, 0x70,
-	0xce, 0xbd, 0x70, 0xe8, 0xa2, 0xf0, 0x39, 0x58, 0x17, 0x92, 0x19, 0x78, 0xe1, 0x0d, 0x14, 0x2e,
-	0x73, 0x1d, 0x61, 0x2c, 0x73, 0xe9, 0xcf, 0x95, 0xeb, 0x26, 0xc6, 0x22, 0x57, 0x2a, 0xa1, 0xfc,
-	0xd3, 0x25, 0x54, 0xd8, 0x94, 0x50, 0xf1, 0xc5, 0x49, 0xa8, 0x74, 0x8e, 0x84, 0xca, 0xff, 0x89,
-	0x84, 0xc0, 0x9a, 0x84, 0x2a, 0x6b, 0x12, 0xaa, 0x9e, 0x27, 0xa1, 0x16, 0xa8, 0xf7, 0x67, 0x1c,
-	0x07, 0xcc, 0xa3, 0xc1, 0xbb, 0xa1, 0xbc, 0x33, 0x56, 0x57, 0x41, 0x7a, 0x20, 0x7f, 0xa9, 0x81,
-	0x4b, 0x6b, 0x57, 0x84, 0x83, 0x59, 0x48, 0x03, 0x26, 0x17, 0x2a, 0x4f, 0x79, 0x4d, 0x1d, 0xe2,
-	0xf2, 0x60, 0xbf, 0x0a, 0xf4, 0x09, 0x25, 0xcc, 0xc8, 0xca, 0x45, 0x5e, 0xda, 0x5c, 0xe4, 0x3e,
-	0x25, 0x8e, 0x0c, 0x81, 0x17, 0x41, 0x2e, 0xc2, 0x5c, 0x6a, 0xa6, 0xea, 0x88, 0x21, 0xac, 0x81,
-	0x52, 0xec, 0x0f, 0x71, 0x14, 0xd1, 0x28, 0x3d, 0x75, 0x8b, 0xb1, 0xdf, 0x17, 0xa6, 0x70, 0x09,
-	0x71, 0x4c, 0x19, 0x1e, 0x29, 0x56, 0x9d, 0x22, 0x41, 0xec, 0x1e, 0xc3, 0xa3, 0xb4, 0xcd, 0xcf,
-	0x34, 0xf0, 0xf2, 0x01, 0x23, 0xf7, 0xc2, 0x11, 0xe2, 0xf8, 0x2e, 0x8a, 0x90, 0xcf, 0xe0, 0x75,
-	0x50, 0x46, 0x53, 0x3e, 0xa6, 0x91, 0xc7, 0xe7, 0xe9, 0x8e, 0x30, 0xbe, 0x7f, 0xd8, 0xd9, 0x4a,
-	0x6f, 0xdb, 0xdd, 0xd1, 0x28, 0xc2, 0x8c, 0xbd, 0xc7, 0x23, 0x2f, 0x20, 0xce, 0x2a, 0x14, 0x5e,
-	0x07, 0x85, 0x50, 0x66, 0x90, 0x62, 0xaf, 0x74, 0x8d, 0xcd, 0x65, 0xa8, 0x0a, 0x3d, 0x5d, 0xd0,
-	0xe4, 0xa4, 0xd1, 0x3b, 0x17, 0x1e, 0xfc, 0xf6, 0xf5, 0xb5, 0x55, 0x9e, 0x56, 0x0d, 0x5c, 0x7e,
-	0xa2, 0xa5, 0x25, 0x76, 0xdd, 0x44, 0x03, 0xb9, 0x03, 0x46, 0xe0, 0x1c, 0x80, 0x33, 0x97, 0xaf,
-	0xb9, 0x59, 0x68, 0x0d, 0xfa, 0xfa, 0x1b, 0xcf, 0x08, 0x58, 0xe6, 0x6f, 0x5d, 0x79, 0xf0, 0xc3,
-	0xaf, 0x9f, 0x67, 0x5f, 0x69, 0xd5, 0xc4, 0xdb, 0x81, 0xb2, 0xd3, 0x87, 0x44, 0x1a, 0x39, 0xe4,
-	0x33, 0xf8, 0x01, 0xa8, 0xae, 0xa1, 0x75, 0xe5, 0x2f, 0x73, 0x9f, 0x0d, 0xa9, 0x5f, 0x7d, 0x66,
-	0xc8, 0xb2, 0x81, 0x5e, 0xff, 0xd1, 0xa2, 0xa1, 0x3d, 0x5e, 0x34, 0xb4, 0x5f, 0x16, 0x0d, 0xed,
-	0xd3, 0xe3, 0x46, 0xe6, 0xf1, 0x71, 0x23, 0xf3, 0xe3, 0x71, 0x23, 0xf3, 0xfe, 0x9b, 0x67, 0x36,
-	0x56, 0x9f, 0xb9, 0x28, 0xe8, 0xf5, 0x6d, 0x1c, 0xa7, 0x0f, 0x9e, 0x78, 0xbb, 0x6b, 0xcf, 0x64,
-	0xb7, 0x72, 0x87, 0x1d, 0x16, 0xe4, 0xc3, 0xe3, 0xad, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x63,
-	0x42, 0x91, 0x73, 0x75, 0x09, 0x00, 0x00,
+	// 1163 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x13, 0xe7, 0xdf, 0x4b, 0x28, 0x2b, 0xd3, 0x52, 0x27, 0x94, 0x38, 0x1b, 0x21, 0xc8,
+	0x56, 0x34, 0xa6, 0x61, 0xb5, 0x87, 0x9e, 0x68, 0xfa, 0x67, 0xd5, 0x55, 0x2b, 0x2a, 0x93, 0xbd,
+	0xb0, 0x48, 0xd5, 0xd4, 0x99, 0x3a, 0x16, 0xb6, 0xc7, 0x78, 0x26, 0xd9, 0x64, 0x25, 0x24, 0xd4,
+	0x13, 0x37, 0x40, 0x7c, 0x01, 0x0e, 0x88, 0x03, 0x27, 0x24, 0xf6, 0x03, 0x70, 0x5c, 0x38, 0xad,
+	0xe0, 0x82, 0x38, 0x04, 0xd4, 0x22, 0x21, 0xf5, 0x06, 0x9f, 0x00, 0x79, 0xec, 0x38, 0x4d, 0x93,
+	0x74, 0xbb, 0xdd, 0x45, 0x9c, 0x3c, 0x6f, 0xde, 0x6f, 0xde, 0x7b, 0xf3, 0x7e, 0x3f, 0xcf, 0x0c,
+	0xe4, 0x31, 0x6b, 0x61, 0xcf, 0x36, 0x1d, 0xa6, 0xe2, 0x8e, 0xad, 0x76, 0x56, 0x54, 0xd6, 0xad,
+	0xba, 0x1e, 0x61, 0x44, 0xba, 0x16, 0xb9, 0xaa, 0xb8, 0x63, 0x57, 0x3b, 0x2b, 0x85, 0x05, 0x9d,
+	0x50, 0x9b, 0x50, 0xd5, 0xa6, 0x86, 0x8f, 0xb4, 0xa9, 0x11, 0x40, 0x0b, 0xf9, 0xc0, 0xb1, 0xcf,
+	0x2d, 0x35, 0x30, 0x42, 0x57, 0x61, 0x2c, 0x81, 0x1f, 0x2c, 0xf0, 0xcd, 0x19, 0xc4, 0x20, 0xc1,
+	0x1a, 0x7f, 0x14, 0xce, 0x2e, 0x1a, 0x84, 0x18, 0x16, 0x56, 0x91, 0x6b, 0xaa, 0xc8, 0x71, 0x08,
+	0x43, 0xcc, 0x24, 0xce, 0x20, 0x5e, 0x3e, 0xf4, 0x72, 0xeb, 0xa0, 0x7d, 0xa8, 0x22, 0xa7, 0x17,
+	0xb8, 0xca, 0x9f, 0x09, 0xf0, 0xc2, 0x2e, 0x35, 0x36, 0xfd, 0x84, 0xb8, 0x6d, 0x37, 0xba, 0x52,
+	0x05, 0xc4, 0x26, 0x62, 0x48, 0x16, 0x4a, 0x42, 0x25, 0x5b, 0x9b, 0xab, 0x06, 0x6b, 0xab, 0x83,
+	0xb5, 0xd5, 0x35, 0xa7, 0xa7, 0x71, 0x84, 0x94, 0x07, 0x91, 0x9a, 0x0f, 0xb0, 0x1c, 0x2b, 0x09,
+	0x15, 0xa1, 0x9e, 0x38, 0xed, 0x2b, 0xc2, 0xb2, 0xc6, 0xa7, 0x24, 0x05, 0xc4, 0x16, 0xa2, 0x2d,
+	0x39, 0x5e, 0x12, 0x2a, 0x99, 0x7a, 0xf6, 0x9f, 0xbe, 0x92, 0xf2, 0x2c, 0x77, 0xb5, 0xbc, 0x5c,
+	0xd6, 0xb8, 0x43, 0x92, 0x40, 0x3c, 0xf4, 0x88, 0x2d, 0x8b, 0x3e, 0x40, 0xe3, 0xe3, 0x55, 0xf1,
+	0xd3, 0xaf, 0x94, 0x99, 0xf2, 0xf7, 0x31, 0x48, 0xef, 0x60, 0x03, 0xe9, 0xbd, 0x46, 0x57, 0x9a,
+	0x83, 0x84, 0x43, 0x1c, 0x1d, 0xf3, 0x6a, 0x44, 0x2d, 0x30, 0xa4, 0xdb, 0x90, 0x31, 0x90, 0xdf,
+	0x39, 0x53, 0x0f, 0xb2, 0x67, 0xea, 0x4b, 0xbf, 0xf5, 0x95, 0xd7, 0x0d, 0x93, 0xb5, 0xda, 0x07,
+	0x55, 0x9d, 0xd8, 0x61, 0x3f, 0xc3, 0xcf, 0x32, 0x6d, 0x7e, 0xa8, 0xb2, 0x9e, 0x8b, 0x69, 0x75,
+	0xdb, 0x61, 0x5a, 0xda, 0x40, 0x74, 0xcf, 0x5f, 0x2b, 0x15, 0x21, 0x6e, 0x20, 0xca, 0xab, 0x14,
+	0xeb, 0xb9, 0xe3, 0xbe, 0x92, 0xbe, 0x8d, 0xe8, 0x8e, 0x69, 0x9b, 0x4c, 0xf3, 0x1d, 0xd2, 0x2c,
+	0xc4, 0x18, 0x09, 0x6b, 0x8c, 0x31, 0x22, 0xdd, 0x81, 0x44, 0x07, 0x59, 0x6d, 0x2c, 0x27, 0x78,
+	0xd2, 0x9b, 0x97, 0x4f, 0x7a, 0xdc, 0x57, 0x92, 0x6b, 0x36, 0x69, 0x3b, 0x4c, 0x0b, 0x42, 0xf8,
+	0x1d, 0xe0, 0x7d, 0x4e, 0x96, 0x84, 0x4a, 0x2e, 0xec, 0x68, 0x0e, 0x84, 0x8e, 0x9c, 0xe2, 0x13,
+	0x42, 0xc7, 0xb7, 0x3c, 0x39, 0x1d, 0x58, 0x9e, 0x6f, 0x51, 0x39, 0x13, 0x58, 0x74, 0x75, 0xd6,
+	0xef, 0xd5, 0x4f, 0x0f, 0x97, 0x93, 0x8d, 0xee, 0x06, 0x62, 0xa8, 0xfc, 0x77, 0x1c, 0x72, 0x6b,
+	0xba, 0x8e, 0x29, 0xdd, 0x31, 0x29, 0x6b, 0x74, 0xa5, 0x7b, 0x90, 0xd6, 0x5b, 0xc8, 0x74, 0xf6,
+	0xcd, 0x26, 0x6f, 0x5e, 0xa6, 0xfe, 0xce, 0x53, 0x55, 0x9b, 0x5a, 0xf7, 0x57, 0x6f, 0x6f, 0x9c,
+	0xf6, 0x95, 0x94, 0x1e, 0x0c, 0xb5, 0x70, 0xd0, 0x1c, 0xd2, 0x12, 0x9b, 0x4a, 0x4b, 0xfc, 0xd9,
+	0x69, 0x11, 0x2f, 0xa6, 0x25, 0x31, 0x4e, 0x4b, 0xf2, 0xf9, 0xd1, 0x92, 0x3a, 0x43, 0xcb, 0x3d,
+	0x48, 0x23, 0xde, 0x5b, 0x4c, 0xe5, 0x74, 0x29, 0x5e, 0xc9, 0xd6, 0x5e, 0xad, 0x9e, 0xff, 0xd1,
+	0xab, 0x41, 0xf7, 0x1b, 0x6d, 0xd7, 0xc2, 0xf5, 0xd2, 0xa3, 0xbe, 0x32, 0x73, 0xda, 0x57, 0x00,
+	0x45, 0x94, 0x7c, 0xfb, 0xbb, 0x02, 0x43, 0x82, 0xb4, 0x28, 0x60, 0xc0, 0x79, 0x66, 0x84, 0x73,
+	0x18, 0xe1, 0x3c, 0x3b, 0x8d, 0xf3, 0x1f, 0x44, 0xc8, 0x6d, 0xf4, 0x1c, 0x64, 0x9b, 0xfa, 0x16,
+	0xc6, 0xff, 0x0f, 0xe7, 0x77, 0x20, 0xeb, 0x73, 0xce, 0x4c, 0x77, 0x5f, 0x47, 0xee, 0x15, 0x58,
+	0xf7, 0x25, 0xd3, 0x30, 0xdd, 0x75, 0xe4, 0x0e, 0x62, 0x1d, 0x62, 0xcc, 0x63, 0x89, 0x57, 0x8a,
+	0xb5, 0x85, 0xb1, 0x1f, 0x2b, 0x94, 0x50, 0xe2, 0x62, 0x09, 0x25, 0xc7, 0x25, 0x94, 0x7a, 0x7e,
+	0x12, 0x4a, 0x4f, 0x91, 0x50, 0xe6, 0x3f, 0x91, 0x10, 0x8c, 0x48, 0x28, 0x3b, 0x22, 0xa1, 0xdc,
+	0x34, 0x09, 0x95, 0xa1, 0xb0, 0xd9, 0x65, 0xd8, 0xa1, 0x26, 0x71, 0xde, 0x75, 0xf9, 0x9d, 0x31,
+	0xbc, 0x0a, 0xc2, 0x03, 0xf9, 0x6b, 0x01, 0xe6, 0x47, 0xae, 0x08, 0x0d, 0x53, 0x97, 0x38, 0x94,
+	0x6f, 0x94, 0x9f, 0xf2, 0x42, 0x70, 0x88, 0xfb, 0x63, 0xe9, 0x06, 0x88, 0x16, 0x31, 0xa8, 0x1c,
+	0xe3, 0x9b, 0x9c, 0x1f, 0xdf, 0xe4, 0x0e, 0x31, 0x34, 0x0e, 0x91, 0xae, 0x41, 0xdc, 0xc3, 0x8c,
+	0x6b, 0x26, 0xa7, 0xf9, 0x43, 0x29, 0x0f, 0xe9, 0x8e, 0xbd, 0x8f, 0x3d, 0x8f, 0x78, 0xe1, 0xa9,
+	0x9b, 0xea, 0xd8, 0x9b, 0xbe, 0xe9, 0xbb, 0x7c, 0x71, 0xb4, 0x29, 0x6e, 0x06, 0xac, 0x6a, 0x29,
+	0x03, 0xd1, 0xbb, 0x14, 0x37, 0xc3, 0x32, 0xbf, 0x10, 0xe0, 0xc5, 0x5d, 0x6a, 0xdc, 0x75, 0x9b,
+	0x88, 0xe1, 0x3d, 0xe4, 0x21, 0x9b, 0x4a, 0xb7, 0x20, 0x83, 0xda, 0xac, 0x45, 0x3c, 0x93, 0xf5,
+	0xc2, 0x3f, 0x42, 0xfe, 0xf9, 0xe1, 0xf2, 0x5c, 0x78, 0xdb, 0xae, 0x35, 0x9b, 0x1e, 0xa6, 0xf4,
+	0x3d, 0xe6, 0x99, 0x8e, 0xa1, 0x0d, 0xa1, 0xd2, 0x2d, 0x48, 0xba, 0x3c, 0x02, 0x17, 0x7b, 0xb6,
+	0x26, 0x8f, 0x6f, 0x23, 0xc8, 0x50, 0x17, 0x7d, 0x9a, 0xb4, 0x10, 0xbd, 0x3a, 0x7b, 0xf4, 0xd7,
+	0x77, 0x4b, 0xc3, 0x38, 0xe5, 0x3c, 0x2c, 0x9c, 0x2b, 0x69, 0xd0, 0xbb, 0xf2, 0x91, 0x00, 0x85,
+	0xc8, 0xb7, 0x81, 0x5d, 0x8b, 0xf4, 0xb0, 0xb7, 0x66, 0x59, 0xe4, 0xbe, 0x65, 0x52, 0x76, 0xe5,
+	0xca, 0x17, 0x21, 0xd3, 0x0c, 0x83, 0x05, 0x1c, 0x64, 0xb4, 0xe1, 0xc4, 0x58, 0x7d, 0xaf, 0x41,
+	0x79, 0x7a, 0x0d, 0x51, 0xa9, 0x9f, 0x08, 0x20, 0x47, 0xb0, 0x2d, 0x8f, 0x3c, 0xc0, 0xce, 0x3a,
+	0x71, 0x98, 0x87, 0x74, 0x46, 0x9f, 0xa5, 0x50, 0x7d, 0x10, 0x64, 0x50, 0x68, 0x34, 0x31, 0x56,
+	0x68, 0x19, 0x4a, 0xd3, 0x2a, 0x88, 0xca, 0xfc, 0x46, 0x80, 0x97, 0x77, 0xa9, 0xa1, 0x61, 0xc3,
+	0xa4, 0x0c, 0x7b, 0x7b, 0x1e, 0x36, 0x1d, 0xca, 0x90, 0x65, 0x5d, 0xbd, 0xc8, 0x0d, 0xc8, 0xba,
+	0xc3, 0x30, 0xa1, 0xa6, 0x17, 0x27, 0x88, 0x21, 0x02, 0x85, 0x82, 0x38, 0xbb, 0x6c, 0x6c, 0x33,
+	0x25, 0x28, 0x4e, 0xae, 0x73, 0xb0, 0x95, 0xda, 0x8f, 0x22, 0xc4, 0x77, 0xa9, 0x21, 0xf5, 0x00,
+	0xce, 0xbc, 0xcc, 0x94, 0xf1, 0xc4, 0x23, 0xff, 0x65, 0xe1, 0x8d, 0x27, 0x00, 0xa2, 0x56, 0x5d,
+	0x3f, 0xfa, 0xe5, 0xcf, 0x2f, 0x63, 0xaf, 0x94, 0xf3, 0xfe, 0xc3, 0x92, 0xd0, 0xe8, 0x95, 0x19,
+	0x22, 0xf7, 0x59, 0x57, 0xfa, 0x00, 0x72, 0x23, 0xbf, 0xd2, 0xf5, 0x89, 0xb1, 0xcf, 0x42, 0x0a,
+	0x37, 0x9e, 0x08, 0x89, 0x4e, 0x8e, 0x8f, 0x61, 0x61, 0x9a, 0xf2, 0xdf, 0xbc, 0x20, 0xca, 0x18,
+	0xba, 0x70, 0xf3, 0x69, 0xd0, 0x51, 0xfa, 0xfb, 0x30, 0x3f, 0x59, 0xcd, 0x4b, 0x17, 0x84, 0x3b,
+	0x87, 0x2d, 0xd4, 0x2e, 0x8f, 0x8d, 0x12, 0x7f, 0x04, 0x2f, 0x4d, 0xd2, 0x67, 0x65, 0x62, 0xa8,
+	0x09, 0xc8, 0xc2, 0x5b, 0x97, 0x45, 0x0e, 0x52, 0xd6, 0xb7, 0x1f, 0x1d, 0x17, 0x85, 0xc7, 0xc7,
+	0x45, 0xe1, 0x8f, 0xe3, 0xa2, 0xf0, 0xf9, 0x49, 0x71, 0xe6, 0xf1, 0x49, 0x71, 0xe6, 0xd7, 0x93,
+	0xe2, 0xcc, 0xfb, 0xea, 0x99, 0x0b, 0x8e, 0x62, 0xaf, 0xc3, 0x9f, 0xf8, 0x3a, 0xb1, 0x88, 0x67,
+	0x70, 0x5b, 0xed, 0xac, 0xd4, 0xd4, 0x2e, 0x17, 0x07, 0xbf, 0xed, 0x0e, 0x92, 0x1c, 0xf1, 0xf6,
+	0xbf, 0x03, 0x00, 0xa5, 0x10, 0x05, 0x4b, 0x01, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -536,6 +837,15 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateDeployerAllowlist defines a governance operation for replacing the list of the addresses
+	// allowed to deploy contracts. The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDeployerAllowlist(ctx context.Context, in *MsgUpdateDeployerAllowlist, opts ...grpc.CallOption) (*MsgUpdateDeployerAllowlistResponse, error)
+	// UpdateFrozenContracts defines a governance operation for replacing the list of the frozen
+	// contracts. The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateFrozenContracts(ctx context.Context, in *MsgUpdateFrozenContracts, opts ...grpc.CallOption) (*MsgUpdateFrozenContractsResponse, error)
+	// RegisterPreinstalls defines a governance operation for placing contracts at fixed addresses.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
 }
 
 type msgClient struct {
@@ -564,6 +874,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateDeployerAllowlist(ctx context.Context, in *MsgUpdateDeployerAllowlist, opts ...grpc.CallOption) (*MsgUpdateDeployerAllowlistResponse, error) {
+	out := new(MsgUpdateDeployerAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateDeployerAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFrozenContracts(ctx context.Context, in *MsgUpdateFrozenContracts, opts ...grpc.CallOption) (*MsgUpdateFrozenContractsResponse, error) {
+	out := new(MsgUpdateFrozenContractsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateFrozenContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error) {
+	out := new(MsgRegisterPreinstallsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/RegisterPreinstalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -571,6 +908,15 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateDeployerAllowlist defines a governance operation for replacing the list of the addresses
+	// allowed to deploy contracts. The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDeployerAllowlist(context.Context, *MsgUpdateDeployerAllowlist) (*MsgUpdateDeployerAllowlistResponse, error)
+	// UpdateFrozenContracts defines a governance operation for replacing the list of the frozen
+	// contracts. The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateFrozenContracts(context.Context, *MsgUpdateFrozenContracts) (*MsgUpdateFrozenContractsResponse, error)
+	// RegisterPreinstalls defines a governance operation for placing contracts at fixed addresses.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +929,15 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDeployerAllowlist(ctx context.Context, req *MsgUpdateDeployerAllowlist) (*MsgUpdateDeployerAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeployerAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFrozenContracts(ctx context.Context, req *MsgUpdateFrozenContracts) (*MsgUpdateFrozenContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFrozenContracts not implemented")
+}
+func (*UnimplementedMsgServer) RegisterPreinstalls(ctx context.Context, req *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +979,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDeployerAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeployerAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeployerAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdateDeployerAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeployerAllowlist(ctx, req.(*MsgUpdateDeployerAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFrozenContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFrozenContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFrozenContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdateFrozenContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFrozenContracts(ctx, req.(*MsgUpdateFrozenContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPreinstalls)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPreinstalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/RegisterPreinstalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPreinstalls(ctx, req.(*MsgRegisterPreinstalls))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +1045,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateDeployerAllowlist",
+			Handler:    _Msg_UpdateDeployerAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdateFrozenContracts",
+			Handler:    _Msg_UpdateFrozenContracts_Handler,
+		},
+		{
+			MethodName: "RegisterPreinstalls",
+			Handler:    _Msg_RegisterPreinstalls_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1185,25 +1606,216 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateDeployerAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
+
+func (m *MsgUpdateDeployerAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeployerAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deployers) > 0 {
+		for iNdEx := len(m.Deployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deployers[iNdEx])
+			copy(dAtA[i:], m.Deployers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Deployers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeployerAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeployerAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeployerAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFrozenContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFrozenContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFrozenContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFrozenContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFrozenContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFrozenContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPreinstalls) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPreinstalls) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPreinstalls) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preinstalls) > 0 {
+		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preinstalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPreinstallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPreinstallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPreinstallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Size_ != 0 {
@@ -1436,6 +2048,90 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateDeployerAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deployers) > 0 {
+		for _, s := range m.Deployers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDeployerAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFrozenContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateFrozenContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterPreinstalls) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Preinstalls) > 0 {
+		for _, e := range m.Preinstalls {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterPreinstallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3179,6 +3875,500 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateDeployerAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeployerAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeployerAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployers = append(m.Deployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDeployerAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeployerAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeployerAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFrozenContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFrozenContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFrozenContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFrozenContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFrozenContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFrozenContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPreinstalls: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPreinstalls: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0